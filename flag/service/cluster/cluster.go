@@ -0,0 +1,18 @@
+package cluster
+
+// Cluster is a data structure to hold tenant cluster health, transition,
+// deletion, node pool deletion, paused cluster, status history, tenant client
+// and tenant API circuit breaker specific configuration flags.
+type Cluster struct {
+	CreationTimeout           string
+	DegradedThreshold         string
+	DeletionTimeout           string
+	NodePoolDrainTimeout      string
+	PausedStatusCollection    string
+	StatusHistoryArchive      string
+	StatusHistoryLimit        string
+	TenantAPICooldown         string
+	TenantAPIFailureThreshold string
+	TenantClientTTL           string
+	UpdateTimeout             string
+}
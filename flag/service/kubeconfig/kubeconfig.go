@@ -6,5 +6,6 @@ import (
 
 // KubeConfig is a data structure to hold kubeconfig specific configuration flags.
 type KubeConfig struct {
-	Secret resource.Secret
+	CertExpiryThreshold string
+	Secret              resource.Secret
 }
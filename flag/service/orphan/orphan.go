@@ -0,0 +1,9 @@
+package orphan
+
+// Orphan is a data structure to hold orphaned resource sweeping specific
+// configuration flags.
+type Orphan struct {
+	Delete      string
+	GracePeriod string
+	Interval    string
+}
@@ -3,5 +3,6 @@ package release
 import "github.com/giantswarm/cluster-operator/v3/flag/service/release/app"
 
 type Release struct {
-	App app.App
+	App    app.App
+	MaxAge string
 }
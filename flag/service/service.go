@@ -3,17 +3,23 @@ package service
 import (
 	"github.com/giantswarm/operatorkit/v5/pkg/flag/service/kubernetes"
 
+	"github.com/giantswarm/cluster-operator/v3/flag/service/cluster"
 	"github.com/giantswarm/cluster-operator/v3/flag/service/image"
 	"github.com/giantswarm/cluster-operator/v3/flag/service/kubeconfig"
+	"github.com/giantswarm/cluster-operator/v3/flag/service/orphan"
 	"github.com/giantswarm/cluster-operator/v3/flag/service/provider"
 	"github.com/giantswarm/cluster-operator/v3/flag/service/release"
+	"github.com/giantswarm/cluster-operator/v3/flag/service/webhook"
 )
 
 // Service is an intermediate data structure for command line configuration flags.
 type Service struct {
+	Cluster    cluster.Cluster
 	Image      image.Image
 	KubeConfig kubeconfig.KubeConfig
 	Kubernetes kubernetes.Kubernetes
+	Orphan     orphan.Orphan
 	Provider   provider.Provider
 	Release    release.Release
+	Webhook    webhook.Webhook
 }
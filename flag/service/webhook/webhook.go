@@ -0,0 +1,10 @@
+package webhook
+
+// Webhook is a data structure to hold admission webhook specific
+// configuration flags.
+type Webhook struct {
+	Address string
+	CrtFile string
+	Enabled string
+	KeyFile string
+}
@@ -18,6 +18,9 @@ require (
 	github.com/giantswarm/operatorkit/v5 v5.0.0
 	github.com/giantswarm/resource/v3 v3.0.2
 	github.com/giantswarm/tenantcluster/v4 v4.1.0
+	github.com/go-kit/kit v0.10.0
+	github.com/google/go-cmp v0.5.6
+	github.com/gorilla/mux v1.8.0
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/prometheus/client_golang v1.11.0
 	github.com/spf13/afero v1.6.0
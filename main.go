@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/microkit/command"
@@ -109,13 +110,27 @@ func mainE() error {
 	daemonCommand := newCommand.DaemonCommand().CobraCommand()
 
 	daemonCommand.PersistentFlags().String(f.Guest.Cluster.Calico.CIDR, "", "Prefix length for the CIDR block used by Calico.")
+	daemonCommand.PersistentFlags().String(f.Guest.Cluster.Calico.Pool.CIDR, "", "CIDR from which pod CIDRs are allocated for clusters not specifying one. Allocation is disabled when empty.")
+	daemonCommand.PersistentFlags().Int(f.Guest.Cluster.Calico.Pool.PrefixLength, 16, "Prefix length of the pod CIDRs allocated from the pod CIDR pool.")
 	daemonCommand.PersistentFlags().String(f.Guest.Cluster.Calico.Subnet, "", "Network address for the CIDR block used by Calico.")
 	daemonCommand.PersistentFlags().String(f.Guest.Cluster.Kubernetes.API.ClusterIPRange, "", "CIDR Range for Pods in cluster.")
 	daemonCommand.PersistentFlags().String(f.Guest.Cluster.Kubernetes.ClusterDomain, "cluster.local", "Internal Kubernetes domain.")
 	daemonCommand.PersistentFlags().String(f.Guest.Cluster.Vault.Certificate.TTL, "", "Vault certificate TTL.")
 
+	daemonCommand.PersistentFlags().Duration(f.Service.Cluster.CreationTimeout, 30*time.Minute, "Duration after which the creation of a tenant cluster is considered stuck. Can be overridden per release.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Cluster.DegradedThreshold, 10*time.Minute, "Duration ready replicas may stay below desired replicas before a tenant cluster is considered degraded.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Cluster.DeletionTimeout, 2*time.Hour, "Duration after which the deletion of a tenant cluster is considered stuck. Finalizers are released afterwards for Cluster CRs annotated with cluster-operator.giantswarm.io/force-delete.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Cluster.NodePoolDrainTimeout, 30*time.Minute, "Duration after which draining the nodes of a deleted node pool is given up on and its deletion proceeds.")
+	daemonCommand.PersistentFlags().Bool(f.Service.Cluster.PausedStatusCollection, true, "Whether status information of paused tenant clusters is still collected while resources managing them are skipped.")
+	daemonCommand.PersistentFlags().Bool(f.Service.Cluster.StatusHistoryArchive, false, "Whether to archive status conditions and versions trimmed from the infrastructure cluster CR in a config map.")
+	daemonCommand.PersistentFlags().Int(f.Service.Cluster.StatusHistoryLimit, 10, "Number of status conditions and versions kept in the status of the infrastructure cluster CR.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Cluster.TenantAPICooldown, 2*time.Minute, "Duration the circuit breaker of an unreachable tenant API stays open before the tenant API is probed again.")
+	daemonCommand.PersistentFlags().Int(f.Service.Cluster.TenantAPIFailureThreshold, 3, "Number of consecutive failed requests against a tenant API after which its circuit breaker opens.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Cluster.TenantClientTTL, 30*time.Minute, "Duration tenant clients are reused for before they are built again.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Cluster.UpdateTimeout, 2*time.Hour, "Duration after which the update of a tenant cluster is considered stuck. Can be overridden per release.")
 	daemonCommand.PersistentFlags().String(f.Service.Image.Registry.Domain, "quay.io", "Image registry.")
 
+	daemonCommand.PersistentFlags().Duration(f.Service.KubeConfig.CertExpiryThreshold, 30*24*time.Hour, "Threshold below which kubeconfig certificates are reported as expiring.")
 	daemonCommand.PersistentFlags().String(f.Service.KubeConfig.Secret.Namespace, "giantswarm", "The namespace where kubeconfig secrets are located.")
 	daemonCommand.PersistentFlags().String(f.Service.Kubernetes.Address, "", "Address used to connect to Kubernetes. When empty in-cluster config is created.")
 	daemonCommand.PersistentFlags().Bool(f.Service.Kubernetes.InCluster, true, "Whether to use the in-cluster config to authenticate with Kubernetes.")
@@ -123,11 +138,21 @@ func mainE() error {
 	daemonCommand.PersistentFlags().String(f.Service.Kubernetes.TLS.CrtFile, "", "Certificate file path to use to authenticate with Kubernetes.")
 	daemonCommand.PersistentFlags().String(f.Service.Kubernetes.TLS.KeyFile, "", "Key file path to use to authenticate with Kubernetes.")
 
+	daemonCommand.PersistentFlags().Bool(f.Service.Orphan.Delete, false, "Whether to delete orphaned resources of clusters without Cluster CR after the grace period. Orphaned resources are only reported otherwise.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Orphan.GracePeriod, 24*time.Hour, "Duration resources have to be found orphaned before they are deleted.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Orphan.Interval, 10*time.Minute, "Duration between two sweeps of orphaned resources.")
+
 	daemonCommand.PersistentFlags().String(f.Service.Provider.Kind, "", "Provider of the installation. One of aws, azure, kvm.")
 
 	daemonCommand.PersistentFlags().String(f.Service.Release.App.Config.Default, "", "Default properties for app.")
 	daemonCommand.PersistentFlags().String(f.Service.Release.App.Config.Override, "", "Overriding properties for app.")
 	daemonCommand.PersistentFlags().Bool(f.Service.Release.App.Config.KiamWatchDogEnabled, true, "Enable Kiam Watchdog.")
+	daemonCommand.PersistentFlags().Duration(f.Service.Release.MaxAge, 0, "Age of releases after which tenant clusters running them are reported as outdated. Zero disables the check.")
+
+	daemonCommand.PersistentFlags().String(f.Service.Webhook.Address, ":8443", "Address the admission webhook server listens on.")
+	daemonCommand.PersistentFlags().String(f.Service.Webhook.CrtFile, "", "Certificate file path served by the admission webhook server.")
+	daemonCommand.PersistentFlags().Bool(f.Service.Webhook.Enabled, false, "Whether to serve the admission webhook rejecting deletions of protected clusters.")
+	daemonCommand.PersistentFlags().String(f.Service.Webhook.KeyFile, "", "Key file path of the certificate served by the admission webhook server.")
 
 	err = newCommand.CobraCommand().Execute()
 	if err != nil {
@@ -0,0 +1,17 @@
+package annotation
+
+const (
+	// APIEndpoint is the name of the annotation on the infrastructure cluster
+	// CR, e.g. AWSCluster, selecting the tenant API endpoint the operator and
+	// the generated kubeconfig use. The value is either APIEndpointPublic,
+	// APIEndpointInternal or a custom FQDN. Defaults to APIEndpointPublic.
+	APIEndpoint = "cluster-operator.giantswarm.io/api-endpoint"
+)
+
+const (
+	// APIEndpointInternal selects the internal-api.<id>.k8s.<base> endpoint,
+	// e.g. for clusters with private API load balancers.
+	APIEndpointInternal = "internal"
+	// APIEndpointPublic selects the api.<id>.k8s.<base> endpoint.
+	APIEndpointPublic = "public"
+)
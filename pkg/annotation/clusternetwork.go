@@ -0,0 +1,13 @@
+package annotation
+
+const (
+	// ClusterDomain is the name of the annotation on the infrastructure cluster
+	// CR, e.g. AWSCluster, overriding the installation's cluster domain of the
+	// tenant cluster, e.g. cluster.local.
+	ClusterDomain = "cluster-operator.giantswarm.io/cluster-domain"
+	// ClusterIPRange is the name of the annotation on the infrastructure
+	// cluster CR, e.g. AWSCluster, overriding the installation's service CIDR of
+	// the tenant cluster, e.g. 172.31.0.0/16. The IPs of the Kubernetes API and
+	// DNS services are derived from it.
+	ClusterIPRange = "cluster-operator.giantswarm.io/cluster-ip-range"
+)
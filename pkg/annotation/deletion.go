@@ -0,0 +1,16 @@
+package annotation
+
+const (
+	// DeletionProtection is the name of the annotation on the Cluster CR
+	// protecting the tenant cluster from being deleted. While the annotation is
+	// present, deletions of the Cluster CR are rejected by the admission
+	// webhook, if enabled, and otherwise not processed by the operator. The
+	// value describes why the tenant cluster is protected.
+	DeletionProtection = "cluster-operator.giantswarm.io/deletion-protection"
+	// ForceDelete is the name of the annotation on the Cluster CR allowing the
+	// operator to release its finalizers once the deletion of the tenant
+	// cluster exceeded the deletion timeout. Objects still blocking the
+	// deletion at that point are orphaned and recorded in the
+	// <cluster-id>-orphaned-resources config map.
+	ForceDelete = "cluster-operator.giantswarm.io/force-delete"
+)
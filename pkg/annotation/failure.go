@@ -0,0 +1,12 @@
+package annotation
+
+const (
+	// FailureMessage is the name of the annotation on MachineDeployment CRs
+	// describing the terminal error reflected in FailureReason.
+	FailureMessage = "cluster-operator.giantswarm.io/failure-message"
+	// FailureReason is the name of the annotation on MachineDeployment CRs
+	// reporting terminal errors of the node pool which require user
+	// intervention. It resembles the failure fields of the Cluster CR status,
+	// which the v1alpha3 MachineDeployment CR status does not provide.
+	FailureReason = "cluster-operator.giantswarm.io/failure-reason"
+)
@@ -0,0 +1,31 @@
+package annotation
+
+const (
+	// Hibernate is the name of the annotation on the Cluster CR scaling all
+	// node pools of the tenant cluster to zero. Once the annotation is removed
+	// the node pools are scaled back to their recorded sizes.
+	Hibernate = "cluster-operator.giantswarm.io/hibernate"
+	// HibernatedMaxSize is the name of the annotation on MachineDeployment CRs
+	// recording the autoscaler maximum size of the node pool before it got
+	// hibernated.
+	HibernatedMaxSize = "cluster-operator.giantswarm.io/hibernated-max-size"
+	// HibernatedMinSize is the name of the annotation on MachineDeployment CRs
+	// recording the autoscaler minimum size of the node pool before it got
+	// hibernated.
+	HibernatedMinSize = "cluster-operator.giantswarm.io/hibernated-min-size"
+	// HibernatedReplicas is the name of the annotation on MachineDeployment
+	// CRs recording the replicas of the node pool before it got hibernated.
+	// The value is empty in case the replicas were not set.
+	HibernatedReplicas = "cluster-operator.giantswarm.io/hibernated-replicas"
+)
+
+const (
+	// AutoscalerMaxSize is the name of the annotation on MachineDeployment CRs
+	// defining the maximum size the cluster autoscaler scales the node pool
+	// to.
+	AutoscalerMaxSize = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
+	// AutoscalerMinSize is the name of the annotation on MachineDeployment CRs
+	// defining the minimum size the cluster autoscaler scales the node pool
+	// to.
+	AutoscalerMinSize = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size"
+)
@@ -0,0 +1,24 @@
+package annotation
+
+const (
+	// KubeConfigCheckTime is the name of the annotation on kubeconfig secrets
+	// holding the RFC 3339 time of the last successful tenant API check done
+	// with the embedded credentials.
+	KubeConfigCheckTime = "cluster-operator.giantswarm.io/kubeconfig-check-time"
+
+	// KubeConfigServerVersion is the name of the annotation on kubeconfig
+	// secrets holding the tenant API server version reported during the last
+	// successful check done with the embedded credentials.
+	KubeConfigServerVersion = "cluster-operator.giantswarm.io/kubeconfig-server-version"
+)
+
+const (
+	// KubeConfigCertExpiry is the name of the annotation on kubeconfig secrets
+	// holding the RFC 3339 expiry time of the embedded client certificate.
+	KubeConfigCertExpiry = "cluster-operator.giantswarm.io/kubeconfig-cert-expiry"
+
+	// KubeConfigCertSerial is the name of the annotation on kubeconfig secrets
+	// holding the serial number of the embedded client certificate as issued
+	// by cert-operator for the app-operator API cert.
+	KubeConfigCertSerial = "cluster-operator.giantswarm.io/kubeconfig-cert-serial"
+)
@@ -0,0 +1,17 @@
+package annotation
+
+const (
+	// DrainCompleted is the name of the annotation on MachineDeployment CRs
+	// set to the time all nodes of the deleted node pool were drained, or
+	// draining them was given up on.
+	DrainCompleted = "cluster-operator.giantswarm.io/drain-completed"
+	// DrainStarted is the name of the annotation on MachineDeployment CRs set
+	// to the time draining the nodes of the deleted node pool started. The
+	// drain timeout is measured from it.
+	DrainStarted = "cluster-operator.giantswarm.io/drain-started"
+	// NodesChanged is the name of the annotation on MachineDeployment and
+	// G8sControlPlane CRs set to the time the nodes of the node pool or control
+	// plane last changed in the tenant cluster. Updating it enqueues the CR for
+	// reconciliation.
+	NodesChanged = "cluster-operator.giantswarm.io/nodes-changed"
+)
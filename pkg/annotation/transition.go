@@ -0,0 +1,12 @@
+package annotation
+
+const (
+	// CreationTimeout is the name of the annotation on the Release CR
+	// overriding the installation's duration after which the creation of tenant
+	// clusters of that release is considered stuck, e.g. 45m.
+	CreationTimeout = "cluster-operator.giantswarm.io/creation-timeout"
+	// UpdateTimeout is the name of the annotation on the Release CR overriding
+	// the installation's duration after which the update of tenant clusters to
+	// that release is considered stuck, e.g. 3h.
+	UpdateTimeout = "cluster-operator.giantswarm.io/update-timeout"
+)
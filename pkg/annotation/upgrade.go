@@ -0,0 +1,10 @@
+package annotation
+
+const (
+	// UpgradeProgress is the name of the annotation on MachineDeployment and
+	// G8sControlPlane CRs reporting the progress of rolling upgrades of the
+	// node pool or the control plane. The value is the number of nodes running
+	// the desired provider operator version and the total number of nodes,
+	// e.g. 2/5.
+	UpgradeProgress = "cluster-operator.giantswarm.io/upgrade-progress"
+)
@@ -0,0 +1,139 @@
+package condition
+
+import (
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+const (
+	// AppsReady is the condition type on the Cluster CR reflecting whether all
+	// apps of the tenant cluster are deployed.
+	AppsReady apiv1alpha3.ConditionType = "AppsReady"
+	// CertificatesReady is the condition type on the Cluster CR reflecting
+	// whether the certificates of all CertConfig CRs of the tenant cluster were
+	// issued.
+	CertificatesReady apiv1alpha3.ConditionType = "CertificatesReady"
+	// ClusterNetworkValid is the condition type on the Cluster CR reflecting
+	// whether the per cluster network settings, e.g. the service CIDR and the
+	// cluster domain, are valid and can be applied to the tenant cluster.
+	ClusterNetworkValid apiv1alpha3.ConditionType = "ClusterNetworkValid"
+	// ControlPlaneReady is the condition type on the Cluster CR reflecting
+	// whether all master nodes of the tenant cluster are ready.
+	ControlPlaneReady = apiv1alpha3.ControlPlaneReadyCondition
+	// Degraded is the condition type on the Cluster CR reflecting whether a
+	// created tenant cluster lost health, e.g. because nodes became NotReady or
+	// report resource pressure. Other than the Ready conditions it is true in
+	// the bad case.
+	Degraded apiv1alpha3.ConditionType = "Degraded"
+	// Deleting is the condition type on the Cluster CR being set once the
+	// Cluster CR got deleted. Its reason and message describe the deletion
+	// step the tenant cluster is waiting for.
+	Deleting apiv1alpha3.ConditionType = "Deleting"
+	// DeletionProtected is the condition type on the Cluster CR being set while
+	// the deletion protection annotation is present. Its reason is set once a
+	// deletion of the Cluster CR is blocked.
+	DeletionProtected apiv1alpha3.ConditionType = "DeletionProtected"
+	// Hibernated is the condition type on the Cluster CR being set while the
+	// tenant cluster is hibernated or restored from hibernation. It is true
+	// once all node pools got scaled to zero and removed once all node pools
+	// are ready again after the hibernation annotation got removed.
+	Hibernated apiv1alpha3.ConditionType = "Hibernated"
+	// NodePoolsReady is the condition type on the Cluster CR reflecting whether
+	// all worker nodes of all node pools of the tenant cluster are ready.
+	NodePoolsReady apiv1alpha3.ConditionType = "NodePoolsReady"
+	// Paused is the condition type on the Cluster CR being set while the
+	// tenant cluster is paused, either by the paused flag of the Cluster CR or
+	// by the Cluster API paused annotation. Resources managing the tenant
+	// cluster are skipped while it is true.
+	Paused apiv1alpha3.ConditionType = "Paused"
+	// Stuck is the condition type on the Cluster CR reflecting whether the
+	// creation or update of the tenant cluster exceeded its timeout. Its reason
+	// names what blocks the transition. Other than the Ready conditions it is
+	// true in the bad case.
+	Stuck apiv1alpha3.ConditionType = "Stuck"
+	// TenantAPIAvailable is the condition type on the Cluster CR reflecting
+	// whether requests against the tenant API are let through by the circuit
+	// breaker of the tenant cluster.
+	TenantAPIAvailable apiv1alpha3.ConditionType = "TenantAPIAvailable"
+	// Ready is the condition type on the Cluster CR summarizing all other
+	// conditions managed by the operator.
+	Ready = apiv1alpha3.ReadyCondition
+)
+
+const (
+	// AppsFailingReason is the reason of a true Stuck condition caused by apps
+	// not being deployed.
+	AppsFailingReason = "AppsFailing"
+	// AppsNotDeployedReason is the reason of a false AppsReady condition.
+	AppsNotDeployedReason = "AppsNotDeployed"
+	// CertificatesNotIssuedReason is the reason of a false CertificatesReady
+	// condition.
+	CertificatesNotIssuedReason = "CertificatesNotIssued"
+	// CircuitBreakerHalfOpenReason is the reason of a false TenantAPIAvailable
+	// condition while the tenant API is probed after the cooldown period.
+	CircuitBreakerHalfOpenReason = "CircuitBreakerHalfOpen"
+	// CircuitBreakerOpenReason is the reason of a false TenantAPIAvailable
+	// condition while requests against the tenant API are short-circuited.
+	CircuitBreakerOpenReason = "CircuitBreakerOpen"
+	// ClusterTransitioningReason is the reason of a false Degraded condition
+	// while the tenant cluster is being created or updated.
+	ClusterTransitioningReason = "ClusterTransitioning"
+	// ControlPlaneNotReadyReason is the reason of a false ControlPlaneReady
+	// condition.
+	ControlPlaneNotReadyReason = "ControlPlaneNotReady"
+	// DeletingReason is the reason of the false Ready condition of deleted
+	// Cluster CRs and of the Deleting condition once no deletion step is
+	// pending anymore.
+	DeletingReason = "Deleting"
+	// DeletionBlockedReason is the reason of a true DeletionProtected
+	// condition of Cluster CRs which got deleted while being protected.
+	DeletionBlockedReason = "DeletionBlocked"
+	// HibernatingReason is the reason of a false Hibernated condition while
+	// the node pools of the tenant cluster are scaled to zero.
+	HibernatingReason = "Hibernating"
+	// InvalidClusterNetworkReason is the reason of a false ClusterNetworkValid
+	// condition.
+	InvalidClusterNetworkReason = "InvalidClusterNetwork"
+	// MastersNotReadyReason is the reason of a true Stuck condition caused by
+	// master nodes not being ready.
+	MastersNotReadyReason = "MastersNotReady"
+	// NodePoolsNotReadyReason is the reason of a false NodePoolsReady
+	// condition.
+	NodePoolsNotReadyReason = "NodePoolsNotReady"
+	// NodePressureReason is the reason of a true Degraded condition caused by
+	// nodes reporting MemoryPressure, DiskPressure or NetworkUnavailable.
+	NodePressureReason = "NodePressure"
+	// NodeVersionsMismatchedReason is the reason of a true Stuck condition
+	// caused by nodes not having the desired provider operator version.
+	NodeVersionsMismatchedReason = "NodeVersionsMismatched"
+	// ReplicasNotReadyReason is the reason of an unknown or true Degraded
+	// condition caused by ready replicas being below desired replicas.
+	ReplicasNotReadyReason = "ReplicasNotReady"
+	// RestoringReason is the reason of a false Hibernated condition while the
+	// node pools of the tenant cluster are scaled back to their recorded sizes.
+	RestoringReason = "Restoring"
+	// TransitionTimeoutExceededReason is the reason of a true Stuck condition
+	// without any specific blocker being found.
+	TransitionTimeoutExceededReason = "TransitionTimeoutExceeded"
+	// WaitingForAppsReason is the reason of the Deleting condition while apps
+	// of the tenant cluster still exist.
+	WaitingForAppsReason = "WaitingForApps"
+	// WaitingForG8sControlPlanesReason is the reason of the Deleting condition
+	// while G8sControlPlane CRs of the tenant cluster still exist.
+	WaitingForG8sControlPlanesReason = "WaitingForG8sControlPlanes"
+	// WaitingForInfrastructureReason is the reason of the Deleting condition
+	// while the infrastructure reference of the Cluster CR still exists.
+	WaitingForInfrastructureReason = "WaitingForInfrastructure"
+	// WaitingForMachineDeploymentsReason is the reason of the Deleting
+	// condition while MachineDeployment CRs of the tenant cluster still exist.
+	WaitingForMachineDeploymentsReason = "WaitingForMachineDeployments"
+	// WaitingForNamespaceReason is the reason of the Deleting condition while
+	// the namespace of the tenant cluster in the control plane still exists.
+	WaitingForNamespaceReason = "WaitingForNamespace"
+	// WaitingForStatusReason is the reason of unknown conditions which were not
+	// computed yet, e.g. because the responsible controller did not reconcile
+	// its CRs yet.
+	WaitingForStatusReason = "WaitingForStatus"
+	// WorkersNotReadyReason is the reason of a true Stuck condition caused by
+	// worker nodes not being ready.
+	WorkersNotReadyReason = "WorkersNotReady"
+)
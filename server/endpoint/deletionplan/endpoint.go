@@ -0,0 +1,117 @@
+package deletionplan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	kitendpoint "github.com/go-kit/kit/endpoint"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+
+	"github.com/giantswarm/cluster-operator/v3/service/deletionplan"
+)
+
+const (
+	// Method is the HTTP method this endpoint is registered for.
+	Method = "GET"
+	// Name identifies the endpoint. It is aligned to the package path.
+	Name = "deletionplan"
+	// Path is the HTTP request path this endpoint is registered for.
+	Path = "/deletionplan/{cluster_id}/"
+)
+
+// Config represents the configuration used to create a deletion plan
+// endpoint.
+type Config struct {
+	Logger  micrologger.Logger
+	Service deletionplan.Interface
+}
+
+// Endpoint serves the plan the cluster controller would follow deleting a
+// tenant cluster, without deleting anything.
+type Endpoint struct {
+	logger  micrologger.Logger
+	service deletionplan.Interface
+}
+
+// New creates a new configured deletion plan endpoint.
+func New(config Config) (*Endpoint, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.Service == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Service must not be empty", config)
+	}
+
+	e := &Endpoint{
+		logger:  config.Logger,
+		service: config.Service,
+	}
+
+	return e, nil
+}
+
+func (e *Endpoint) Decoder() kithttp.DecodeRequestFunc {
+	return func(ctx context.Context, r *http.Request) (interface{}, error) {
+		request := Request{
+			ClusterID: mux.Vars(r)["cluster_id"],
+		}
+
+		return request, nil
+	}
+}
+
+func (e *Endpoint) Encoder() kithttp.EncodeResponseFunc {
+	return func(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+		return json.NewEncoder(w).Encode(response)
+	}
+}
+
+func (e *Endpoint) Endpoint() kitendpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		r := request.(Request)
+
+		p, err := e.service.Plan(ctx, r.ClusterID)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		response := &Response{
+			ClusterID: p.ClusterID,
+			Blocked:   p.Blocked,
+			Steps:     []ResponseStep{},
+		}
+		for _, s := range p.Steps {
+			response.Steps = append(response.Steps, ResponseStep{
+				Action:    s.Action,
+				Kind:      s.Kind,
+				Name:      s.Name,
+				Namespace: s.Namespace,
+				Resource:  s.Resource,
+			})
+		}
+
+		return response, nil
+	}
+}
+
+func (e *Endpoint) Method() string {
+	return Method
+}
+
+func (e *Endpoint) Middlewares() []kitendpoint.Middleware {
+	return []kitendpoint.Middleware{}
+}
+
+func (e *Endpoint) Name() string {
+	return Name
+}
+
+func (e *Endpoint) Path() string {
+	return Path
+}
@@ -0,0 +1,6 @@
+package deletionplan
+
+// Request is the input of the deletion plan endpoint.
+type Request struct {
+	ClusterID string
+}
@@ -0,0 +1,17 @@
+package deletionplan
+
+// Response is the deletion plan of a tenant cluster. The steps are ordered the
+// way the cluster controller executes them.
+type Response struct {
+	ClusterID string         `json:"cluster_id"`
+	Blocked   bool           `json:"blocked"`
+	Steps     []ResponseStep `json:"steps"`
+}
+
+type ResponseStep struct {
+	Action    string `json:"action"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Resource  string `json:"resource"`
+}
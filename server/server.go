@@ -13,6 +13,7 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/server/endpoint"
 	"github.com/giantswarm/cluster-operator/v3/server/middleware"
 	"github.com/giantswarm/cluster-operator/v3/service"
+	"github.com/giantswarm/cluster-operator/v3/service/deletionplan"
 )
 
 // Config represents the configuration used to construct server object.
@@ -88,6 +89,7 @@ func New(config Config) (*Server, error) {
 			Viper:       config.Viper,
 
 			Endpoints: []microserver.Endpoint{
+				endpointCollection.DeletionPlan,
 				endpointCollection.Healthz,
 				endpointCollection.Version,
 			},
@@ -119,6 +121,13 @@ func errorEncoder(ctx context.Context, err error, w http.ResponseWriter) {
 	rErr := err.(microserver.ResponseError)
 	uErr := rErr.Underlying()
 
+	if deletionplan.IsNotFound(uErr) {
+		rErr.SetCode(microserver.CodeResourceNotFound)
+		rErr.SetMessage(uErr.Error())
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
 	rErr.SetCode(microserver.CodeInternalError)
 	rErr.SetMessage(uErr.Error())
 	w.WriteHeader(http.StatusInternalServerError)
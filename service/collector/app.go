@@ -0,0 +1,227 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	g8slabel "github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
+)
+
+const (
+	// appStatusMissing is the status of apps of the release for which no App
+	// CR exists.
+	appStatusMissing = "missing"
+)
+
+var (
+	appLastDeployed *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemApp, "last_deployed_seconds"),
+		"Seconds since the app was last deployed successfully as provided by the App CR status. Only exported for apps which were deployed successfully before, regardless of their current release status.",
+		[]string{
+			"cluster_id",
+			"app",
+		},
+		nil,
+	)
+	appStatus *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemApp, "status"),
+		"Versions and release status of the apps of a cluster as provided by the Release CR and the App CRs, e.g. deployed, failed, pending-install or missing.",
+		[]string{
+			"cluster_id",
+			"app",
+			"desired_version",
+			"spec_version",
+			"deployed_version",
+			"status",
+		},
+		nil,
+	)
+)
+
+type AppConfig struct {
+	K8sClient      k8sclient.Interface
+	Logger         micrologger.Logger
+	ReleaseVersion releaseversion.Interface
+}
+
+// App implements the App interface, exposing the versions and release status
+// of the apps managed for tenant clusters.
+type App struct {
+	k8sClient      k8sclient.Interface
+	logger         micrologger.Logger
+	releaseVersion releaseversion.Interface
+}
+
+// appInfo is the status of a single app of a tenant cluster.
+type appInfo struct {
+	App             string
+	DeployedVersion string
+	DesiredVersion  string
+	// LastDeployed is the time of the last successful deployment of the app.
+	// It is zero for apps which were never deployed successfully.
+	LastDeployed time.Time
+	SpecVersion  string
+	Status       string
+}
+
+func NewApp(config AppConfig) (*App, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.ReleaseVersion == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ReleaseVersion must not be empty", config)
+	}
+
+	a := &App{
+		k8sClient:      config.K8sClient,
+		logger:         config.Logger,
+		releaseVersion: config.ReleaseVersion,
+	}
+
+	return a, nil
+}
+
+func (a *App) Collect(ch chan<- prometheus.Metric) error {
+	ctx := context.Background()
+
+	var list apiv1alpha3.ClusterList
+	{
+		err := a.k8sClient.CtrlClient().List(
+			ctx,
+			&list,
+			client.MatchingLabels{label.OperatorVersion: project.Version()},
+		)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	for _, cl := range list.Items {
+		cl := cl // dereferencing pointer value into new scope
+
+		// Apps of deleted clusters are removed together with the cluster
+		// namespace and not of interest anymore.
+		if key.IsDeleted(&cl) {
+			continue
+		}
+
+		releaseApps, err := a.releaseVersion.Apps(ctx, &cl)
+		if releaseversion.IsNotFound(err) {
+			a.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("could not find release of cluster %#q", key.ClusterID(&cl)), "stack", microerror.JSON(err))
+			continue
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		var apps []applicationv1alpha1.App
+		{
+			o := metav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s=%s", label.ManagedBy, project.Name()),
+			}
+
+			list, err := a.k8sClient.G8sClient().ApplicationV1alpha1().Apps(key.ClusterID(&cl)).List(ctx, o)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			apps = list.Items
+		}
+
+		now := time.Now()
+		for _, info := range appInfos(releaseApps, apps) {
+			ch <- prometheus.MustNewConstMetric(
+				appStatus,
+				prometheus.GaugeValue,
+				GaugeValue,
+				key.ClusterID(&cl),
+				info.App,
+				info.DesiredVersion,
+				info.SpecVersion,
+				info.DeployedVersion,
+				info.Status,
+			)
+
+			if !info.LastDeployed.IsZero() {
+				ch <- prometheus.MustNewConstMetric(
+					appLastDeployed,
+					prometheus.GaugeValue,
+					now.Sub(info.LastDeployed).Seconds(),
+					key.ClusterID(&cl),
+					info.App,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *App) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- appLastDeployed
+	ch <- appStatus
+	return nil
+}
+
+// appInfos merges the apps of the release of a tenant cluster with its App
+// CRs. App CRs are matched by their app name label, since the App CR name
+// might differ from the app name in the release. The returned list is sorted
+// by app name.
+func appInfos(releaseApps map[string]releaseversion.ReleaseApp, apps []applicationv1alpha1.App) []appInfo {
+	infos := map[string]appInfo{}
+
+	for name, app := range releaseApps {
+		infos[name] = appInfo{
+			App:            name,
+			DesiredVersion: app.Version,
+			Status:         appStatusMissing,
+		}
+	}
+
+	for _, app := range apps {
+		name := app.GetLabels()[g8slabel.AppKubernetesName]
+		if name == "" {
+			name = app.GetName()
+		}
+
+		info := infos[name]
+		info.App = name
+		info.DeployedVersion = app.Status.Version
+		info.SpecVersion = app.Spec.Version
+		info.Status = strings.ToLower(app.Status.Release.Status)
+		// The time of the last successful deployment is kept while the app
+		// is e.g. failing, so that the time since is exported as well.
+		info.LastDeployed = app.Status.Release.LastDeployed.Time
+
+		infos[name] = info
+	}
+
+	var list []appInfo
+	for _, info := range infos {
+		list = append(list, info)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].App < list[j].App
+	})
+
+	return list
+}
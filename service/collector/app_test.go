@@ -0,0 +1,156 @@
+package collector
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	g8slabel "github.com/giantswarm/apiextensions/v3/pkg/label"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
+)
+
+func Test_appInfos(t *testing.T) {
+	deployed := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	newApp := func(name string, label string, specVersion string, version string, status string) applicationv1alpha1.App {
+		app := applicationv1alpha1.App{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: applicationv1alpha1.AppSpec{
+				Version: specVersion,
+			},
+			Status: applicationv1alpha1.AppStatus{
+				Release: applicationv1alpha1.AppStatusRelease{
+					Status: status,
+				},
+				Version: version,
+			},
+		}
+
+		// Apps without deployed version were never deployed successfully.
+		if version != "" {
+			app.Status.Release.LastDeployed = metav1.NewTime(deployed)
+		}
+
+		if label != "" {
+			app.SetLabels(map[string]string{
+				g8slabel.AppKubernetesName: label,
+			})
+		}
+
+		return app
+	}
+
+	testCases := []struct {
+		name          string
+		releaseApps   map[string]releaseversion.ReleaseApp
+		apps          []applicationv1alpha1.App
+		expectedInfos []appInfo
+	}{
+		{
+			name: "case 0: deployed app",
+			releaseApps: map[string]releaseversion.ReleaseApp{
+				"coredns": {Version: "1.6.0"},
+			},
+			apps: []applicationv1alpha1.App{
+				newApp("coredns", "coredns", "1.6.0", "1.6.0", "deployed"),
+			},
+			expectedInfos: []appInfo{
+				{
+					App:             "coredns",
+					DeployedVersion: "1.6.0",
+					DesiredVersion:  "1.6.0",
+					LastDeployed:    deployed,
+					SpecVersion:     "1.6.0",
+					Status:          "deployed",
+				},
+			},
+		},
+		{
+			name: "case 1: failed app overridden by the user",
+			releaseApps: map[string]releaseversion.ReleaseApp{
+				"coredns": {Version: "1.6.0"},
+			},
+			apps: []applicationv1alpha1.App{
+				newApp("coredns", "coredns", "1.7.0-rc1", "1.6.0", "FAILED"),
+			},
+			expectedInfos: []appInfo{
+				{
+					App:             "coredns",
+					DeployedVersion: "1.6.0",
+					DesiredVersion:  "1.6.0",
+					LastDeployed:    deployed,
+					SpecVersion:     "1.7.0-rc1",
+					Status:          "failed",
+				},
+			},
+		},
+		{
+			name: "case 2: app of the release without App CR",
+			releaseApps: map[string]releaseversion.ReleaseApp{
+				"cert-exporter": {Version: "1.2.0"},
+				"coredns":       {Version: "1.6.0"},
+			},
+			apps: []applicationv1alpha1.App{
+				newApp("coredns", "coredns", "1.6.0", "", "pending-install"),
+			},
+			expectedInfos: []appInfo{
+				{
+					App:            "cert-exporter",
+					DesiredVersion: "1.2.0",
+					Status:         appStatusMissing,
+				},
+				{
+					App:            "coredns",
+					DesiredVersion: "1.6.0",
+					SpecVersion:    "1.6.0",
+					Status:         "pending-install",
+				},
+			},
+		},
+		{
+			name: "case 3: App CR named differently than the app of the release",
+			releaseApps: map[string]releaseversion.ReleaseApp{
+				"kiam": {Version: "1.7.0"},
+			},
+			apps: []applicationv1alpha1.App{
+				newApp("kiam-app", "kiam", "1.7.0", "1.7.0", "deployed"),
+				newApp("chart-operator", "", "2.0.0", "2.0.0", "deployed"),
+			},
+			expectedInfos: []appInfo{
+				{
+					App:             "chart-operator",
+					DeployedVersion: "2.0.0",
+					LastDeployed:    deployed,
+					SpecVersion:     "2.0.0",
+					Status:          "deployed",
+				},
+				{
+					App:             "kiam",
+					DeployedVersion: "1.7.0",
+					DesiredVersion:  "1.7.0",
+					LastDeployed:    deployed,
+					SpecVersion:     "1.7.0",
+					Status:          "deployed",
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			infos := appInfos(tc.releaseApps, tc.apps)
+
+			if !reflect.DeepEqual(infos, tc.expectedInfos) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedInfos, infos)
+			}
+		})
+	}
+}
@@ -11,14 +11,36 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
 	"github.com/giantswarm/cluster-operator/v3/pkg/label"
 	"github.com/giantswarm/cluster-operator/v3/pkg/project"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
 )
 
 var (
+	clusterDegraded *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemCluster, "degraded"),
+		"Whether the cluster is degraded as provided by the Degraded condition of the Cluster CR.",
+		[]string{
+			"cluster_id",
+			"release_version",
+			"reason",
+		},
+		nil,
+	)
+	clusterPaused *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemCluster, "paused"),
+		"Whether the cluster is paused by the paused flag or the paused annotation of the Cluster CR.",
+		[]string{
+			"cluster_id",
+			"release_version",
+		},
+		nil,
+	)
 	clusterStatus *prometheus.Desc = prometheus.NewDesc(
 		prometheus.BuildFQName(namespace, subsystemCluster, "status"),
 		"Latest cluster status conditions as provided by the Cluster CR status.",
@@ -85,6 +107,24 @@ func (c *Cluster) Collect(ch chan<- prometheus.Metric) error {
 	for _, cl := range list.Items {
 		cl := cl // dereferencing pointer value into new scope
 
+		{
+			ch <- prometheus.MustNewConstMetric(
+				clusterDegraded,
+				prometheus.GaugeValue,
+				boolToFloat64(conditions.IsTrue(&cl, condition.Degraded)),
+				key.ClusterID(&cl),
+				key.ReleaseVersion(&cl),
+				conditions.GetReason(&cl, condition.Degraded),
+			)
+			ch <- prometheus.MustNewConstMetric(
+				clusterPaused,
+				prometheus.GaugeValue,
+				boolToFloat64(annotations.IsPaused(&cl, &cl)),
+				key.ClusterID(&cl),
+				key.ReleaseVersion(&cl),
+			)
+		}
+
 		cr := c.newCommonClusterObjectFunc()
 		{
 			err := c.k8sClient.CtrlClient().Get(
@@ -150,6 +190,8 @@ func (c *Cluster) Collect(ch chan<- prometheus.Metric) error {
 }
 
 func (c *Cluster) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- clusterDegraded
+	ch <- clusterPaused
 	ch <- clusterStatus
 	return nil
 }
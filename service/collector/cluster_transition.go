@@ -3,14 +3,17 @@ package collector
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/prometheus/client_golang/prometheus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -19,22 +22,26 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
 )
 
+const (
+	transitionCreation = "creation"
+	transitionDeletion = "deletion"
+	transitionUpdate   = "update"
+)
+
+// transitionBuckets are the upper bounds in seconds of the histogram buckets
+// tracking the durations of cluster transitions.
+var transitionBuckets = []float64{300, 600, 900, 1200, 1800, 2700, 3600, 5400, 7200, 10800, 14400}
+
 var (
-	clusterTransitionCreateDesc *prometheus.Desc = prometheus.NewDesc(
-		prometheus.BuildFQName(namespace, subsystemCluster, "create_transition"),
-		"Latest cluster creation transition.",
-		[]string{
-			"cluster_id",
-			"release_version",
-		},
-		nil,
-	)
-	clusterTransitionUpdateDesc *prometheus.Desc = prometheus.NewDesc(
-		prometheus.BuildFQName(namespace, subsystemCluster, "update_transition"),
-		"Latest cluster update transition.",
+	clusterTransitionInFlightDesc *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemCluster, "transition_in_flight_seconds"),
+		"Age of cluster creations, updates and deletions currently in flight.",
 		[]string{
 			"cluster_id",
+			"transition",
+			"provider",
 			"release_version",
+			"previous_release_version",
 		},
 		nil,
 	)
@@ -45,15 +52,36 @@ type ClusterTransitionConfig struct {
 	Logger    micrologger.Logger
 
 	NewCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	Provider                   string
 }
 
 // ClusterTransition implements the ClusterTransition interface, exposing
-// cluster transition information.
+// cluster transition information. Creations, updates and deletions are
+// tracked while being in flight and observed once they complete. Transitions
+// completing while the operator is not running are therefore not observed.
 type ClusterTransition struct {
 	k8sClient k8sclient.Interface
 	logger    micrologger.Logger
 
 	newCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	provider                   string
+
+	mutex     sync.Mutex
+	inFlight  map[string]transition
+	durations map[string]*prometheus.HistogramVec
+}
+
+// transition is a creation, update or deletion of a tenant cluster.
+type transition struct {
+	ClusterID string
+	Kind      string
+	Name      string
+	Namespace string
+	// PreviousReleaseVersion is the release version a tenant cluster is
+	// updated from. It is empty for creations and deletions.
+	PreviousReleaseVersion string
+	ReleaseVersion         string
+	Start                  time.Time
 }
 
 //NewClusterTransition initiates cluster transition metrics
@@ -68,12 +96,37 @@ func NewClusterTransition(config ClusterTransitionConfig) (*ClusterTransition, e
 	if config.NewCommonClusterObjectFunc == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.NewCommonClusterObjectFunc must not be empty", config)
 	}
+	if config.Provider == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Provider must not be empty", config)
+	}
+
+	durations := map[string]*prometheus.HistogramVec{}
+	for _, kind := range []string{transitionCreation, transitionDeletion, transitionUpdate} {
+		durations[kind] = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: subsystemCluster,
+				Name:      fmt.Sprintf("%s_duration_seconds", kind),
+				Help:      fmt.Sprintf("Durations of completed cluster %ss.", kind),
+				Buckets:   transitionBuckets,
+			},
+			[]string{
+				"provider",
+				"release_version",
+				"previous_release_version",
+			},
+		)
+	}
 
 	ct := &ClusterTransition{
 		k8sClient: config.K8sClient,
 		logger:    config.Logger,
 
 		newCommonClusterObjectFunc: config.NewCommonClusterObjectFunc,
+		provider:                   config.Provider,
+
+		inFlight:  map[string]transition{},
+		durations: durations,
 	}
 
 	return ct, nil
@@ -81,6 +134,7 @@ func NewClusterTransition(config ClusterTransitionConfig) (*ClusterTransition, e
 
 func (ct *ClusterTransition) Collect(ch chan<- prometheus.Metric) error {
 	ctx := context.Background()
+	now := time.Now()
 
 	var list apiv1alpha3.ClusterList
 	{
@@ -94,94 +148,211 @@ func (ct *ClusterTransition) Collect(ch chan<- prometheus.Metric) error {
 		}
 	}
 
+	current := map[string]transition{}
+	statuses := map[string]*infrastructurev1alpha3.CommonClusterStatus{}
 	for _, cl := range list.Items {
 		cl := cl // dereferencing pointer value into new scope
 
-		cr := ct.newCommonClusterObjectFunc()
+		// The status of deleted clusters may not exist anymore, in which case
+		// only their deletion is tracked.
+		var status infrastructurev1alpha3.CommonClusterStatus
 		{
+			cr := ct.newCommonClusterObjectFunc()
 			err := ct.k8sClient.CtrlClient().Get(
 				ctx,
 				key.ObjRefToNamespacedName(key.ObjRefFromCluster(cl)),
 				cr,
 			)
 			if apierrors.IsNotFound(err) {
-				ct.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("could not find object reference %#q", cl.GetName()))
-				continue
+				if !key.IsDeleted(&cl) {
+					ct.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("could not find object reference %#q", cl.GetName()))
+					continue
+				}
 			} else if err != nil {
 				return microerror.Mask(err)
+			} else {
+				status = cr.GetCommonClusterStatus()
 			}
 		}
-		{
-			created, createTime := getCreateMetrics(cr.GetCommonClusterStatus())
-			if created {
-				ch <- prometheus.MustNewConstMetric(
-					clusterTransitionCreateDesc,
-					prometheus.GaugeValue,
-					createTime,
-					key.ClusterID(cr),
-					key.ReleaseVersion(cr),
-				)
+
+		statuses[key.ClusterID(&cl)] = &status
+
+		for _, t := range inFlightTransitions(cl, status) {
+			current[t.key()] = t
+		}
+	}
+
+	ct.mutex.Lock()
+	defer ct.mutex.Unlock()
+
+	for k, t := range ct.inFlight {
+		if _, ok := current[k]; ok {
+			continue
+		}
+
+		// Cluster CRs missing in the list are either gone or reconciled by
+		// another operator version now. Only deletions are completed by the
+		// former.
+		status, ok := statuses[t.ClusterID]
+		if !ok {
+			var gone bool
+			if t.Kind == transitionDeletion {
+				var err error
+				gone, err = ct.isGone(ctx, t)
+				if err != nil {
+					return microerror.Mask(err)
+				}
 			}
-			updated, updateTime := getUpdateMetrics(cr.GetCommonClusterStatus())
-			if updated {
-				ch <- prometheus.MustNewConstMetric(
-					clusterTransitionUpdateDesc,
-					prometheus.GaugeValue,
-					updateTime,
-					key.ClusterID(cr),
-					key.ReleaseVersion(cr),
-				)
+
+			if !gone {
+				delete(ct.inFlight, k)
+				continue
 			}
 		}
+
+		d, ok := completedDuration(t, status, now)
+		if ok {
+			ct.durations[t.Kind].WithLabelValues(ct.provider, t.ReleaseVersion, t.PreviousReleaseVersion).Observe(d.Seconds())
+		}
+
+		delete(ct.inFlight, k)
+	}
+
+	// Transitions are only added once, so that the release version a tenant
+	// cluster is updated from is kept as it was when the update started.
+	for k, t := range current {
+		if _, ok := ct.inFlight[k]; !ok {
+			ct.inFlight[k] = t
+		}
+
+		t = ct.inFlight[k]
+
+		ch <- prometheus.MustNewConstMetric(
+			clusterTransitionInFlightDesc,
+			prometheus.GaugeValue,
+			now.Sub(t.Start).Seconds(),
+			t.ClusterID,
+			t.Kind,
+			ct.provider,
+			t.ReleaseVersion,
+			t.PreviousReleaseVersion,
+		)
+	}
+
+	for _, d := range ct.durations {
+		d.Collect(ch)
+	}
+
+	return nil
+}
+
+func (ct *ClusterTransition) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- clusterTransitionInFlightDesc
+
+	for _, d := range ct.durations {
+		d.Describe(ch)
 	}
+
 	return nil
 }
 
-func getCreateMetrics(status infrastructurev1alpha3.CommonClusterStatus) (bool, float64) {
-	if status.HasCreatingCondition() && status.HasCreatedCondition() {
-		t1 := status.GetCreatingCondition().LastTransitionTime.Time
-		t2 := status.GetCreatedCondition().LastTransitionTime.Time
-		return true, t2.Sub(t1).Seconds()
+// isGone returns whether the Cluster CR of the given transition does not exist
+// anymore.
+func (ct *ClusterTransition) isGone(ctx context.Context, t transition) (bool, error) {
+	err := ct.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: t.Name, Namespace: t.Namespace}, &apiv1alpha3.Cluster{})
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return false, nil
+}
+
+func (t transition) key() string {
+	return fmt.Sprintf("%s/%s/%d", t.ClusterID, t.Kind, t.Start.Unix())
+}
+
+// inFlightTransitions returns the transitions of the given tenant cluster
+// which are currently in flight. Deleted tenant clusters are only considered
+// being deleted, regardless of their status conditions.
+func inFlightTransitions(cl apiv1alpha3.Cluster, status infrastructurev1alpha3.CommonClusterStatus) []transition {
+	t := transition{
+		ClusterID:      key.ClusterID(&cl),
+		Name:           cl.GetName(),
+		Namespace:      cl.GetNamespace(),
+		ReleaseVersion: key.ReleaseVersion(&cl),
+	}
+
+	if key.IsDeleted(&cl) {
+		t.Kind = transitionDeletion
+		t.Start = cl.GetDeletionTimestamp().Time
+		return []transition{t}
 	}
 
 	if status.HasCreatingCondition() && !status.HasCreatedCondition() {
-		t1 := status.GetCreatingCondition().LastTransitionTime.Time
+		t.Kind = transitionCreation
+		t.Start = status.GetCreatingCondition().LastTransitionTime.Time
+		return []transition{t}
+	}
+
+	if status.LatestCondition() == infrastructurev1alpha3.ClusterStatusConditionUpdating {
+		t.Kind = transitionUpdate
+		t.Start = status.GetUpdatingCondition().LastTransitionTime.Time
 
-		// If the Creating condition is too old without having any
-		// Created condition given, we put the cluster into the last
-		// bucket and consider it invalid in that regard.
-		if time.Now().After(t1.Add(30 * time.Minute)) {
-			return true, float64(999999999999)
+		previous := cl.GetAnnotations()[annotation.LastDeployedReleaseVersion]
+		if previous != t.ReleaseVersion {
+			t.PreviousReleaseVersion = previous
 		}
+
+		return []transition{t}
 	}
-	return false, 0
+
+	return nil
 }
-func getUpdateMetrics(status infrastructurev1alpha3.CommonClusterStatus) (bool, float64) {
 
-	if status.HasUpdatingCondition() && status.HasUpdatedCondition() {
-		t1 := status.GetUpdatingCondition().LastTransitionTime.Time
-		t2 := status.GetUpdatedCondition().LastTransitionTime.Time
-		if t2.Sub(t1).Seconds() > 0 {
-			return true, t2.Sub(t1).Seconds()
+// completedDuration returns the duration of the given transition once it is
+// not in flight anymore. The status is nil for tenant clusters whose Cluster
+// CR is gone. False is returned for transitions which got aborted, e.g. the
+// creation of a tenant cluster deleted before being created.
+func completedDuration(t transition, status *infrastructurev1alpha3.CommonClusterStatus, now time.Time) (time.Duration, bool) {
+	if t.Kind == transitionDeletion {
+		if status != nil {
+			return 0, false
 		}
+
+		return now.Sub(t.Start), true
 	}
 
-	if status.HasUpdatingCondition() {
-		t1 := status.GetUpdatingCondition().LastTransitionTime.Time
+	if status == nil {
+		return 0, false
+	}
+
+	var condition string
+	switch t.Kind {
+	case transitionCreation:
+		condition = infrastructurev1alpha3.ClusterStatusConditionCreated
+	case transitionUpdate:
+		condition = infrastructurev1alpha3.ClusterStatusConditionUpdated
+	default:
+		return 0, false
+	}
 
-		// If the Updating condition is too old without having any
-		// Updated condition given, we put the cluster into the last
-		// bucket and consider it invalid in that regard.
-		if time.Now().After(t1.Add(2 * time.Hour)) {
-			return true, float64(999999999999)
+	// The earliest matching condition after the start of the transition
+	// completed it. Conditions of previous transitions are ignored.
+	var end time.Time
+	for _, c := range status.Conditions {
+		if c.Condition != condition || c.LastTransitionTime.Time.Before(t.Start) {
+			continue
+		}
+		if end.IsZero() || c.LastTransitionTime.Time.Before(end) {
+			end = c.LastTransitionTime.Time
 		}
 	}
-	return false, 0
-}
 
-func (ct *ClusterTransition) Describe(ch chan<- *prometheus.Desc) error {
-	ch <- clusterTransitionCreateDesc
-	ch <- clusterTransitionUpdateDesc
+	if end.IsZero() {
+		return 0, false
+	}
 
-	return nil
+	return end.Sub(t.Start), true
 }
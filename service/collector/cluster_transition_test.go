@@ -3,48 +3,53 @@ package collector
 import (
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
 )
 
-func TestCollectClusterTransition(t *testing.T) {
+func Test_inFlightTransitions(t *testing.T) {
+	deleted := metav1.NewTime(time.Now().Add(-15 * time.Minute))
+
 	testCases := []struct {
-		name   string
-		status infrastructurev1alpha3.CommonClusterStatus
+		name              string
+		annotations       map[string]string
+		deletionTimestamp *metav1.Time
+		status            infrastructurev1alpha3.CommonClusterStatus
 
-		expectCreated int
-		expectUpdated int
+		expectedKind     string
+		expectedPrevious string
 	}{
-		// the cluster is creating
 		{
-			name: "case 0",
+			name: "case 0: the cluster is creating",
 			status: infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
 					unittest.GetCreatingCondition(20),
 				},
 			},
-
-			expectCreated: 0,
-			expectUpdated: 0,
+			expectedKind: transitionCreation,
 		},
-		// the cluster is created
 		{
-			name: "case 1",
+			name: "case 1: the cluster is created",
 			status: infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
 					unittest.GetCreatedCondition(0),
 					unittest.GetCreatingCondition(30),
 				},
 			},
-
-			expectCreated: 30*60 - 1,
-			expectUpdated: 0,
+			expectedKind: "",
 		},
-		// the cluster is updating
 		{
-			name: "case 2",
+			name: "case 2: the cluster is updating",
+			annotations: map[string]string{
+				annotation.LastDeployedReleaseVersion: "14.0.0",
+			},
 			status: infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
 					unittest.GetUpdatingCondition(30),
@@ -52,13 +57,14 @@ func TestCollectClusterTransition(t *testing.T) {
 					unittest.GetCreatingCondition(90),
 				},
 			},
-
-			expectCreated: 30*60 - 1,
-			expectUpdated: 0,
+			expectedKind:     transitionUpdate,
+			expectedPrevious: "14.0.0",
 		},
-		// the cluster is updated
 		{
-			name: "case 3",
+			name: "case 3: the cluster is updated",
+			annotations: map[string]string{
+				annotation.LastDeployedReleaseVersion: "14.1.0",
+			},
 			status: infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
 					unittest.GetUpdatedCondition(10),
@@ -67,13 +73,13 @@ func TestCollectClusterTransition(t *testing.T) {
 					unittest.GetCreatingCondition(90),
 				},
 			},
-
-			expectCreated: 30*60 - 1,
-			expectUpdated: 20*60 - 1,
+			expectedKind: "",
 		},
-		// the cluster is updating for the second time
 		{
-			name: "case 4",
+			name: "case 4: the cluster is updating for the second time",
+			annotations: map[string]string{
+				annotation.LastDeployedReleaseVersion: "14.1.0",
+			},
 			status: infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
 					unittest.GetUpdatingCondition(10),
@@ -83,79 +89,187 @@ func TestCollectClusterTransition(t *testing.T) {
 					unittest.GetCreatingCondition(90),
 				},
 			},
-			expectCreated: 30*60 - 1,
-			expectUpdated: 0,
+			expectedKind: transitionUpdate,
 		},
-		// the cluster is updated for the second time
 		{
-			name: "case 5",
+			name:              "case 5: the cluster is deleting",
+			deletionTimestamp: &deleted,
 			status: infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
-					unittest.GetUpdatedCondition(5),
-					unittest.GetUpdatingCondition(10),
-					unittest.GetUpdatedCondition(20),
-					unittest.GetUpdatingCondition(40),
+					unittest.GetUpdatingCondition(30),
 					unittest.GetCreatedCondition(60),
 					unittest.GetCreatingCondition(90),
 				},
 			},
+			expectedKind: transitionDeletion,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			cl := apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "8y5ck",
+					Namespace:         "default",
+					Annotations:       tc.annotations,
+					DeletionTimestamp: tc.deletionTimestamp,
+					Labels: map[string]string{
+						label.Cluster:        "8y5ck",
+						label.ReleaseVersion: "14.1.0",
+					},
+				},
+			}
+
+			transitions := inFlightTransitions(cl, tc.status)
+
+			if tc.expectedKind == "" {
+				if len(transitions) != 0 {
+					t.Fatalf("expected %#v to be equal to %#v", 0, len(transitions))
+				}
+				return
+			}
+
+			if len(transitions) != 1 {
+				t.Fatalf("expected %#v to be equal to %#v", 1, len(transitions))
+			}
+			if transitions[0].Kind != tc.expectedKind {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedKind, transitions[0].Kind)
+			}
+			if transitions[0].ReleaseVersion != "14.1.0" {
+				t.Fatalf("expected %#q to be equal to %#q", "14.1.0", transitions[0].ReleaseVersion)
+			}
+			if transitions[0].PreviousReleaseVersion != tc.expectedPrevious {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedPrevious, transitions[0].PreviousReleaseVersion)
+			}
+		})
+	}
+}
+
+func Test_completedDuration(t *testing.T) {
+	minutesAgo := func(m time.Duration) time.Time {
+		return time.Now().Add(-m * time.Minute)
+	}
+
+	testCases := []struct {
+		name       string
+		transition transition
+		status     *infrastructurev1alpha3.CommonClusterStatus
 
-			expectCreated: 30*60 - 1,
-			expectUpdated: 5*60 - 1,
+		expectedCompleted bool
+		expectedMinutes   int
+	}{
+		{
+			name: "case 0: creation completed",
+			transition: transition{
+				Kind:  transitionCreation,
+				Start: minutesAgo(30),
+			},
+			status: &infrastructurev1alpha3.CommonClusterStatus{
+				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+					unittest.GetCreatedCondition(0),
+					unittest.GetCreatingCondition(30),
+				},
+			},
+			expectedCompleted: true,
+			expectedMinutes:   30,
 		},
-		// stuck creating
 		{
-			name: "case 6",
-			status: infrastructurev1alpha3.CommonClusterStatus{
+			name: "case 1: creation not completed",
+			transition: transition{
+				Kind:  transitionCreation,
+				Start: minutesAgo(30),
+			},
+			status: &infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
-					unittest.GetCreatingCondition(90),
+					unittest.GetCreatingCondition(30),
 				},
 			},
-
-			expectCreated: 999999999999,
-			expectUpdated: 0,
+			expectedCompleted: false,
 		},
-		// stuck updating
 		{
-			name: "case 7",
-			status: infrastructurev1alpha3.CommonClusterStatus{
+			name: "case 2: creation aborted by the deletion of the cluster",
+			transition: transition{
+				Kind:  transitionCreation,
+				Start: minutesAgo(30),
+			},
+			status:            nil,
+			expectedCompleted: false,
+		},
+		{
+			name: "case 3: second update completed",
+			transition: transition{
+				Kind:  transitionUpdate,
+				Start: minutesAgo(10),
+			},
+			status: &infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
-					unittest.GetUpdatingCondition(200),
-					unittest.GetCreatedCondition(300),
-					unittest.GetCreatingCondition(330),
+					unittest.GetUpdatedCondition(5),
+					unittest.GetUpdatingCondition(10),
+					unittest.GetUpdatedCondition(20),
+					unittest.GetUpdatingCondition(40),
+					unittest.GetCreatedCondition(60),
+					unittest.GetCreatingCondition(90),
 				},
 			},
-
-			expectCreated: 30*60 - 1,
-			expectUpdated: 999999999999,
+			expectedCompleted: true,
+			expectedMinutes:   5,
 		},
-		// the cluster is stuck updating for the second timne
 		{
-			name: "case 8",
-			status: infrastructurev1alpha3.CommonClusterStatus{
+			name: "case 4: second update not completed",
+			transition: transition{
+				Kind:  transitionUpdate,
+				Start: minutesAgo(10),
+			},
+			status: &infrastructurev1alpha3.CommonClusterStatus{
 				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
-					unittest.GetUpdatingCondition(180),
-					unittest.GetUpdatedCondition(200),
-					unittest.GetUpdatingCondition(220),
-					unittest.GetCreatedCondition(300),
-					unittest.GetCreatingCondition(330),
+					unittest.GetUpdatingCondition(10),
+					unittest.GetUpdatedCondition(20),
+					unittest.GetUpdatingCondition(40),
+					unittest.GetCreatedCondition(60),
+					unittest.GetCreatingCondition(90),
 				},
 			},
-
-			expectCreated: 30*60 - 1,
-			expectUpdated: 999999999999,
+			expectedCompleted: false,
+		},
+		{
+			name: "case 5: deletion completed",
+			transition: transition{
+				Kind:  transitionDeletion,
+				Start: minutesAgo(15),
+			},
+			status:            nil,
+			expectedCompleted: true,
+			expectedMinutes:   15,
+		},
+		{
+			name: "case 6: deletion not completed",
+			transition: transition{
+				Kind:  transitionDeletion,
+				Start: minutesAgo(15),
+			},
+			status:            &infrastructurev1alpha3.CommonClusterStatus{},
+			expectedCompleted: false,
 		},
 	}
 
 	for i, tc := range testCases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			_, created := getCreateMetrics(tc.status)
-			if int(created) != tc.expectCreated {
-				t.Fatalf("expected %v, got %v", tc.expectCreated, int(created))
+			t.Log(tc.name)
+
+			d, completed := completedDuration(tc.transition, tc.status, time.Now())
+
+			if completed != tc.expectedCompleted {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedCompleted, completed)
 			}
-			_, updated := getUpdateMetrics(tc.status)
-			if int(updated) != tc.expectUpdated {
-				t.Fatalf("expected %v, got %v", tc.expectUpdated, int(updated))
+			if !completed {
+				return
+			}
+
+			minutes := int(d.Round(time.Minute).Minutes())
+			if minutes != tc.expectedMinutes {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedMinutes, minutes)
 			}
 		})
 	}
@@ -1,8 +1,14 @@
 package collector
 
 const (
-	GaugeValue        float64 = 1
-	namespace         string  = "cluster_operator"
-	subsystemCluster  string  = "cluster"
-	subsystemNodePool string  = "node_pool"
+	GaugeValue                float64 = 1
+	namespace                 string  = "cluster_operator"
+	subsystemApp              string  = "app"
+	subsystemCluster          string  = "cluster"
+	subsystemControlPlane     string  = "control_plane"
+	subsystemKubeConfig       string  = "kubeconfig"
+	subsystemNodePool         string  = "node_pool"
+	subsystemOrphanedResource string  = "orphaned_resource"
+	subsystemRelease          string  = "release"
+	subsystemTenantClient     string  = "tenant_client"
 )
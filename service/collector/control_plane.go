@@ -0,0 +1,130 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+var (
+	controlPlaneMasters *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemControlPlane, "masters"),
+		"Number of masters of the control plane for a specific cluster as provided by the G8sControlPlane CR associated with a given cluster ID.",
+		[]string{
+			"cluster_id",
+			"control_plane_id",
+		},
+		nil,
+	)
+
+	controlPlaneUpdatedMasters *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemControlPlane, "updated_masters"),
+		"Number of masters running the desired provider operator version in the control plane for a specific cluster as provided by the G8sControlPlane CR associated with a given cluster ID.",
+		[]string{
+			"cluster_id",
+			"control_plane_id",
+		},
+		nil,
+	)
+)
+
+type ControlPlaneConfig struct {
+	K8sClient k8sclient.Interface
+	Logger    micrologger.Logger
+}
+
+type ControlPlane struct {
+	k8sClient k8sclient.Interface
+	logger    micrologger.Logger
+}
+
+func NewControlPlane(config ControlPlaneConfig) (*ControlPlane, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	cp := &ControlPlane{
+		k8sClient: config.K8sClient,
+		logger:    config.Logger,
+	}
+
+	return cp, nil
+}
+
+func (cp *ControlPlane) Collect(ch chan<- prometheus.Metric) error {
+	ctx := context.Background()
+
+	var list infrastructurev1alpha3.G8sControlPlaneList
+	{
+		err := cp.k8sClient.CtrlClient().List(
+			ctx,
+			&list,
+			client.MatchingLabels{label.OperatorVersion: project.Version()},
+		)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	for _, g := range list.Items {
+		g := g // dereferencing pointer value into new scope
+
+		ch <- prometheus.MustNewConstMetric(
+			controlPlaneMasters,
+			prometheus.GaugeValue,
+			float64(g.Status.Replicas),
+			key.ClusterID(&g),
+			g.GetLabels()[label.ControlPlane],
+		)
+
+		// The upgrade progress is only known once the control plane got
+		// reconciled with the upgrade progress annotation.
+		updated, ok := updatedMasters(g.GetAnnotations()[annotation.UpgradeProgress])
+		if ok {
+			ch <- prometheus.MustNewConstMetric(
+				controlPlaneUpdatedMasters,
+				prometheus.GaugeValue,
+				float64(updated),
+				key.ClusterID(&g),
+				g.GetLabels()[label.ControlPlane],
+			)
+		}
+	}
+
+	return nil
+}
+
+func (cp *ControlPlane) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- controlPlaneMasters
+	ch <- controlPlaneUpdatedMasters
+
+	return nil
+}
+
+// updatedMasters parses the number of upgraded nodes from the given value of
+// the upgrade progress annotation, e.g. 2 for 2/3. False is returned in case
+// the value is empty or malformed.
+func updatedMasters(progress string) (int32, bool) {
+	var upgraded, nodes int32
+
+	_, err := fmt.Sscanf(progress, "%d/%d", &upgraded, &nodes)
+	if err != nil {
+		return 0, false
+	}
+
+	return upgraded, true
+}
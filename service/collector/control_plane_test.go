@@ -0,0 +1,47 @@
+package collector
+
+import (
+	"strconv"
+	"testing"
+)
+
+func Test_updatedMasters(t *testing.T) {
+	testCases := []struct {
+		name             string
+		progress         string
+		expectedUpdated  int32
+		expectedProgress bool
+	}{
+		{
+			name:             "case 0: annotation not set",
+			progress:         "",
+			expectedProgress: false,
+		},
+		{
+			name:             "case 1: upgrade in progress",
+			progress:         "2/3",
+			expectedUpdated:  2,
+			expectedProgress: true,
+		},
+		{
+			name:             "case 2: malformed annotation",
+			progress:         "two of three",
+			expectedProgress: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			updated, ok := updatedMasters(tc.progress)
+
+			if ok != tc.expectedProgress {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedProgress, ok)
+			}
+			if updated != tc.expectedUpdated {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedUpdated, updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+var (
+	kubeConfigCertExpiry *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemKubeConfig, "cert_expiry_timestamp_seconds"),
+		"Expiry time of the client certificate embedded into the kubeconfig secret of a cluster.",
+		[]string{
+			"cluster_id",
+			"serial",
+		},
+		nil,
+	)
+	kubeConfigCertExpiring *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemKubeConfig, "cert_expiring"),
+		"Whether the client certificate embedded into the kubeconfig secret of a cluster expires within the configured threshold.",
+		[]string{
+			"cluster_id",
+			"serial",
+		},
+		nil,
+	)
+)
+
+type KubeConfigConfig struct {
+	K8sClient k8sclient.Interface
+	Logger    micrologger.Logger
+
+	CertExpiryThreshold time.Duration
+}
+
+// KubeConfig exposes information about the client certificates embedded into
+// the kubeconfig secrets managed by the operator.
+type KubeConfig struct {
+	k8sClient k8sclient.Interface
+	logger    micrologger.Logger
+
+	certExpiryThreshold time.Duration
+}
+
+func NewKubeConfig(config KubeConfigConfig) (*KubeConfig, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if config.CertExpiryThreshold <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CertExpiryThreshold must be greater than zero", config)
+	}
+
+	k := &KubeConfig{
+		k8sClient: config.K8sClient,
+		logger:    config.Logger,
+
+		certExpiryThreshold: config.CertExpiryThreshold,
+	}
+
+	return k, nil
+}
+
+func (k *KubeConfig) Collect(ch chan<- prometheus.Metric) error {
+	ctx := context.Background()
+
+	list, err := k.k8sClient.K8sClient().CoreV1().Secrets(metav1.NamespaceAll).List(
+		ctx,
+		metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(map[string]string{label.ManagedBy: project.Name()}).String(),
+		},
+	)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, s := range list.Items {
+		s := s // dereferencing pointer value into new scope
+
+		if s.Name != key.KubeConfigSecretName(&s) {
+			continue
+		}
+
+		v, ok := s.Annotations[annotation.KubeConfigCertExpiry]
+		if !ok {
+			continue
+		}
+
+		expiry, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			k.logger.LogCtx(ctx, "level", "warning", "message", fmt.Sprintf("could not parse certificate expiry of secret %#q", s.Name), "stack", microerror.JSON(err))
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			kubeConfigCertExpiry,
+			prometheus.GaugeValue,
+			float64(expiry.Unix()),
+			key.ClusterID(&s),
+			s.Annotations[annotation.KubeConfigCertSerial],
+		)
+		ch <- prometheus.MustNewConstMetric(
+			kubeConfigCertExpiring,
+			prometheus.GaugeValue,
+			boolToFloat64(time.Until(expiry) < k.certExpiryThreshold),
+			key.ClusterID(&s),
+			s.Annotations[annotation.KubeConfigCertSerial],
+		)
+	}
+
+	return nil
+}
+
+func (k *KubeConfig) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- kubeConfigCertExpiry
+	ch <- kubeConfigCertExpiring
+
+	return nil
+}
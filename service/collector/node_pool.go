@@ -44,6 +44,16 @@ var (
 		},
 		nil,
 	)
+
+	nodePoolUpdatedWorkers *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemNodePool, "updated_workers"),
+		"Number of workers running the desired provider operator version in all node pools for a specific cluster as provided by the MachineDeployment CRs associated with a given cluster ID.",
+		[]string{
+			"cluster_id",
+			"node_pool_id",
+		},
+		nil,
+	)
 )
 
 type NodePoolConfig struct {
@@ -91,6 +101,7 @@ func (np *NodePool) Collect(ch chan<- prometheus.Metric) error {
 		id      string
 		desired int
 		ready   int
+		updated int
 	}
 
 	nodePoolMap := make(map[string][]nodePool)
@@ -102,6 +113,7 @@ func (np *NodePool) Collect(ch chan<- prometheus.Metric) error {
 			id:      key.MachineDeployment(&md),
 			desired: int(md.Status.Replicas),
 			ready:   int(md.Status.ReadyReplicas),
+			updated: int(md.Status.UpdatedReplicas),
 		}
 
 		nodePoolMap[key.ClusterID(&md)] = append(nodePoolMap[key.ClusterID(&md)], np)
@@ -133,6 +145,14 @@ func (np *NodePool) Collect(ch chan<- prometheus.Metric) error {
 				cid,
 				np.id,
 			)
+
+			ch <- prometheus.MustNewConstMetric(
+				nodePoolUpdatedWorkers,
+				prometheus.GaugeValue,
+				float64(np.updated),
+				cid,
+				np.id,
+			)
 		}
 	}
 
@@ -143,6 +163,7 @@ func (np *NodePool) Describe(ch chan<- *prometheus.Desc) error {
 	ch <- nodePoolCount
 	ch <- nodePoolDesiredWorkers
 	ch <- nodePoolReadyWorkers
+	ch <- nodePoolUpdatedWorkers
 
 	return nil
 }
@@ -0,0 +1,94 @@
+package collector
+
+import (
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/orphansweeper"
+)
+
+var (
+	orphanedResourceAge *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemOrphanedResource, "age_seconds"),
+		"Time since the resource managed by the operator was first found without Cluster CR of its cluster.",
+		[]string{
+			"cluster_id",
+			"kind",
+			"name",
+			"namespace",
+		},
+		nil,
+	)
+
+	orphanedResourceDeleted *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemOrphanedResource, "deleted_total"),
+		"Number of orphaned resources deleted after their grace period.",
+		[]string{
+			"kind",
+		},
+		nil,
+	)
+)
+
+type OrphanedResourceConfig struct {
+	Logger        micrologger.Logger
+	OrphanSweeper orphansweeper.Interface
+}
+
+// OrphanedResource exposes the resources found orphaned by the latest sweep of
+// the orphan sweeper.
+type OrphanedResource struct {
+	logger        micrologger.Logger
+	orphanSweeper orphansweeper.Interface
+}
+
+func NewOrphanedResource(config OrphanedResourceConfig) (*OrphanedResource, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.OrphanSweeper == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.OrphanSweeper must not be empty", config)
+	}
+
+	o := &OrphanedResource{
+		logger:        config.Logger,
+		orphanSweeper: config.OrphanSweeper,
+	}
+
+	return o, nil
+}
+
+func (o *OrphanedResource) Collect(ch chan<- prometheus.Metric) error {
+	for _, r := range o.orphanSweeper.Orphans() {
+		ch <- prometheus.MustNewConstMetric(
+			orphanedResourceAge,
+			prometheus.GaugeValue,
+			time.Since(r.Since).Seconds(),
+			r.ClusterID,
+			r.Kind,
+			r.Name,
+			r.Namespace,
+		)
+	}
+
+	for kind, n := range o.orphanSweeper.Deleted() {
+		ch <- prometheus.MustNewConstMetric(
+			orphanedResourceDeleted,
+			prometheus.CounterValue,
+			float64(n),
+			kind,
+		)
+	}
+
+	return nil
+}
+
+func (o *OrphanedResource) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- orphanedResourceAge
+	ch <- orphanedResourceDeleted
+
+	return nil
+}
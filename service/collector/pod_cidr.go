@@ -0,0 +1,73 @@
+package collector
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
+)
+
+var (
+	podCIDRConflicts *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemCluster, "pod_cidr_conflicts"),
+		"Number of other clusters the pod CIDR of a cluster overlaps with. Clusters whose pod CIDR overlaps with their own service CIDR count themselves.",
+		[]string{
+			"cluster_id",
+		},
+		nil,
+	)
+)
+
+type PodCIDRConfig struct {
+	Logger  micrologger.Logger
+	PodCIDR podcidr.Interface
+}
+
+// PodCIDR exposes pod CIDRs overlapping between tenant clusters.
+type PodCIDR struct {
+	logger  micrologger.Logger
+	podCIDR podcidr.Interface
+}
+
+func NewPodCIDR(config PodCIDRConfig) (*PodCIDR, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.PodCIDR == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.PodCIDR must not be empty", config)
+	}
+
+	p := &PodCIDR{
+		logger:  config.Logger,
+		podCIDR: config.PodCIDR,
+	}
+
+	return p, nil
+}
+
+func (p *PodCIDR) Collect(ch chan<- prometheus.Metric) error {
+	conflicts, err := p.podCIDR.Conflicts(context.Background())
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for id, ids := range conflicts {
+		ch <- prometheus.MustNewConstMetric(
+			podCIDRConflicts,
+			prometheus.GaugeValue,
+			float64(len(ids)),
+			id,
+		)
+	}
+
+	return nil
+}
+
+func (p *PodCIDR) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- podCIDRConflicts
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package collector
+
+import (
+	"context"
+	"time"
+
+	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/prometheus/client_golang/prometheus"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+const (
+	// releaseEOLDeprecated is the reason of tenant clusters running a release
+	// whose Release CR is marked deprecated.
+	releaseEOLDeprecated = "deprecated"
+	// releaseEOLMissing is the reason of tenant clusters running a release for
+	// which no Release CR exists.
+	releaseEOLMissing = "missing"
+	// releaseEOLOutdated is the reason of tenant clusters running a release
+	// older than the configured maximum age.
+	releaseEOLOutdated = "outdated"
+)
+
+var (
+	releaseClustersDesc *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemRelease, "clusters"),
+		"Number of tenant clusters running a release.",
+		[]string{
+			"release_version",
+		},
+		nil,
+	)
+	releaseClusterEOLDesc *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemRelease, "cluster_eol"),
+		"Tenant clusters running a release which is deprecated, missing or older than the configured maximum age.",
+		[]string{
+			"cluster_id",
+			"release_version",
+			"reason",
+		},
+		nil,
+	)
+)
+
+type ReleaseConfig struct {
+	K8sClient k8sclient.Interface
+	Logger    micrologger.Logger
+
+	// MaxAge is the age of releases after which tenant clusters running them
+	// are reported as outdated. Zero disables the check.
+	MaxAge time.Duration
+}
+
+// Release implements the Release interface, exposing the distribution of
+// release versions across tenant clusters and the tenant clusters running
+// releases which reached their end of life.
+type Release struct {
+	k8sClient k8sclient.Interface
+	logger    micrologger.Logger
+
+	maxAge time.Duration
+}
+
+func NewRelease(config ReleaseConfig) (*Release, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if config.MaxAge < 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.MaxAge must not be negative", config)
+	}
+
+	r := &Release{
+		k8sClient: config.K8sClient,
+		logger:    config.Logger,
+
+		maxAge: config.MaxAge,
+	}
+
+	return r, nil
+}
+
+func (r *Release) Collect(ch chan<- prometheus.Metric) error {
+	ctx := context.Background()
+	now := time.Now()
+
+	var clusters apiv1alpha3.ClusterList
+	{
+		err := r.k8sClient.CtrlClient().List(
+			ctx,
+			&clusters,
+			client.MatchingLabels{label.OperatorVersion: project.Version()},
+		)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	releases := map[string]releasev1alpha1.Release{}
+	{
+		var list releasev1alpha1.ReleaseList
+		err := r.k8sClient.CtrlClient().List(ctx, &list)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		for _, re := range list.Items {
+			releases[re.GetName()] = re
+		}
+	}
+
+	counts := map[string]int{}
+	for _, cl := range clusters.Items {
+		cl := cl // dereferencing pointer value into new scope
+
+		// Deleted clusters are not upgraded anymore and therefore not of
+		// interest for upgrade campaigns.
+		if key.IsDeleted(&cl) {
+			continue
+		}
+
+		version := key.ReleaseVersion(&cl)
+		counts[version]++
+
+		var release *releasev1alpha1.Release
+		if re, ok := releases[key.ReleaseName(version)]; ok && version != "" {
+			release = &re
+		}
+
+		for _, reason := range releaseEOLReasons(release, r.maxAge, now) {
+			ch <- prometheus.MustNewConstMetric(
+				releaseClusterEOLDesc,
+				prometheus.GaugeValue,
+				GaugeValue,
+				key.ClusterID(&cl),
+				version,
+				reason,
+			)
+		}
+	}
+
+	for version, count := range counts {
+		ch <- prometheus.MustNewConstMetric(
+			releaseClustersDesc,
+			prometheus.GaugeValue,
+			float64(count),
+			version,
+		)
+	}
+
+	return nil
+}
+
+func (r *Release) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- releaseClustersDesc
+	ch <- releaseClusterEOLDesc
+	return nil
+}
+
+// releaseEOLReasons returns the reasons why the given release reached its end
+// of life. The release is nil for tenant clusters whose Release CR does not
+// exist. The age of a release is measured from the date it became active,
+// falling back to the creation of its Release CR. A maxAge of zero disables
+// the age check.
+func releaseEOLReasons(release *releasev1alpha1.Release, maxAge time.Duration, now time.Time) []string {
+	if release == nil {
+		return []string{releaseEOLMissing}
+	}
+
+	var reasons []string
+
+	if release.Spec.State == releasev1alpha1.StateDeprecated {
+		reasons = append(reasons, releaseEOLDeprecated)
+	}
+
+	if maxAge > 0 {
+		date := release.GetCreationTimestamp().Time
+		if release.Spec.Date != nil {
+			date = release.Spec.Date.Time
+		}
+
+		if !date.IsZero() && now.Sub(date) > maxAge {
+			reasons = append(reasons, releaseEOLOutdated)
+		}
+	}
+
+	return reasons
+}
@@ -0,0 +1,114 @@
+package collector
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_releaseEOLReasons(t *testing.T) {
+	now := time.Now()
+	daysAgo := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(-d * 24 * time.Hour))
+		return &t
+	}
+
+	testCases := []struct {
+		name    string
+		release *releasev1alpha1.Release
+		maxAge  time.Duration
+
+		expectedReasons []string
+	}{
+		{
+			name:            "case 0: the release is missing",
+			release:         nil,
+			maxAge:          180 * 24 * time.Hour,
+			expectedReasons: []string{releaseEOLMissing},
+		},
+		{
+			name: "case 1: the release is active and recent",
+			release: &releasev1alpha1.Release{
+				Spec: releasev1alpha1.ReleaseSpec{
+					Date:  daysAgo(30),
+					State: releasev1alpha1.StateActive,
+				},
+			},
+			maxAge:          180 * 24 * time.Hour,
+			expectedReasons: nil,
+		},
+		{
+			name: "case 2: the release is deprecated",
+			release: &releasev1alpha1.Release{
+				Spec: releasev1alpha1.ReleaseSpec{
+					Date:  daysAgo(30),
+					State: releasev1alpha1.StateDeprecated,
+				},
+			},
+			maxAge:          180 * 24 * time.Hour,
+			expectedReasons: []string{releaseEOLDeprecated},
+		},
+		{
+			name: "case 3: the release is active and outdated",
+			release: &releasev1alpha1.Release{
+				Spec: releasev1alpha1.ReleaseSpec{
+					Date:  daysAgo(200),
+					State: releasev1alpha1.StateActive,
+				},
+			},
+			maxAge:          180 * 24 * time.Hour,
+			expectedReasons: []string{releaseEOLOutdated},
+		},
+		{
+			name: "case 4: the release is deprecated and outdated",
+			release: &releasev1alpha1.Release{
+				Spec: releasev1alpha1.ReleaseSpec{
+					Date:  daysAgo(200),
+					State: releasev1alpha1.StateDeprecated,
+				},
+			},
+			maxAge:          180 * 24 * time.Hour,
+			expectedReasons: []string{releaseEOLDeprecated, releaseEOLOutdated},
+		},
+		{
+			name: "case 5: the age check is disabled",
+			release: &releasev1alpha1.Release{
+				Spec: releasev1alpha1.ReleaseSpec{
+					Date:  daysAgo(200),
+					State: releasev1alpha1.StateActive,
+				},
+			},
+			maxAge:          0,
+			expectedReasons: nil,
+		},
+		{
+			name: "case 6: the release without date is outdated by its creation",
+			release: &releasev1alpha1.Release{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: *daysAgo(200),
+				},
+				Spec: releasev1alpha1.ReleaseSpec{
+					State: releasev1alpha1.StateActive,
+				},
+			},
+			maxAge:          180 * 24 * time.Hour,
+			expectedReasons: []string{releaseEOLOutdated},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			reasons := releaseEOLReasons(tc.release, tc.maxAge, now)
+
+			if !reflect.DeepEqual(reasons, tc.expectedReasons) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedReasons, reasons)
+			}
+		})
+	}
+}
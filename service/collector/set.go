@@ -1,20 +1,34 @@
 package collector
 
 import (
+	"time"
+
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/certs/v3/pkg/certs"
 	"github.com/giantswarm/exporterkit/collector"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/orphansweeper"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
 )
 
 type SetConfig struct {
-	CertSearcher certs.Interface
-	K8sClient    k8sclient.Interface
-	Logger       micrologger.Logger
-
-	NewCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	CertSearcher   certs.Interface
+	K8sClient      k8sclient.Interface
+	Logger         micrologger.Logger
+	OrphanSweeper  orphansweeper.Interface
+	PodCIDR        podcidr.Interface
+	ReleaseVersion releaseversion.Interface
+	TenantClient   tenantclient.Interface
+
+	KubeConfigCertExpiryThreshold time.Duration
+	NewCommonClusterObjectFunc    func() infrastructurev1alpha3.CommonClusterObject
+	Provider                      string
+	ReleaseMaxAge                 time.Duration
 }
 
 // Set is basically only a wrapper for the operator's collector implementations.
@@ -27,6 +41,20 @@ type Set struct {
 func NewSet(config SetConfig) (*Set, error) {
 	var err error
 
+	var appCollector *App
+	{
+		c := AppConfig{
+			K8sClient:      config.K8sClient,
+			Logger:         config.Logger,
+			ReleaseVersion: config.ReleaseVersion,
+		}
+
+		appCollector, err = NewApp(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var clusterCollector *Cluster
 	{
 		c := ClusterConfig{
@@ -42,6 +70,19 @@ func NewSet(config SetConfig) (*Set, error) {
 		}
 	}
 
+	var controlPlaneCollector *ControlPlane
+	{
+		c := ControlPlaneConfig{
+			K8sClient: config.K8sClient,
+			Logger:    config.Logger,
+		}
+
+		controlPlaneCollector, err = NewControlPlane(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var nodePoolCollector *NodePool
 	{
 		c := NodePoolConfig{
@@ -62,6 +103,7 @@ func NewSet(config SetConfig) (*Set, error) {
 			Logger:    config.Logger,
 
 			NewCommonClusterObjectFunc: config.NewCommonClusterObjectFunc,
+			Provider:                   config.Provider,
 		}
 
 		clusterTransitionCollector, err = NewClusterTransition(c)
@@ -70,13 +112,89 @@ func NewSet(config SetConfig) (*Set, error) {
 		}
 	}
 
+	var kubeConfigCollector *KubeConfig
+	{
+		c := KubeConfigConfig{
+			K8sClient: config.K8sClient,
+			Logger:    config.Logger,
+
+			CertExpiryThreshold: config.KubeConfigCertExpiryThreshold,
+		}
+
+		kubeConfigCollector, err = NewKubeConfig(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var orphanedResourceCollector *OrphanedResource
+	{
+		c := OrphanedResourceConfig{
+			Logger:        config.Logger,
+			OrphanSweeper: config.OrphanSweeper,
+		}
+
+		orphanedResourceCollector, err = NewOrphanedResource(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var podCIDRCollector *PodCIDR
+	{
+		c := PodCIDRConfig{
+			Logger:  config.Logger,
+			PodCIDR: config.PodCIDR,
+		}
+
+		podCIDRCollector, err = NewPodCIDR(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var releaseCollector *Release
+	{
+		c := ReleaseConfig{
+			K8sClient: config.K8sClient,
+			Logger:    config.Logger,
+
+			MaxAge: config.ReleaseMaxAge,
+		}
+
+		releaseCollector, err = NewRelease(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var tenantClientCollector *TenantClient
+	{
+		c := TenantClientConfig{
+			Logger:       config.Logger,
+			TenantClient: config.TenantClient,
+		}
+
+		tenantClientCollector, err = NewTenantClient(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var collectorSet *collector.Set
 	{
 		c := collector.SetConfig{
 			Collectors: []collector.Interface{
+				appCollector,
 				clusterCollector,
+				controlPlaneCollector,
 				nodePoolCollector,
 				clusterTransitionCollector,
+				kubeConfigCollector,
+				orphanedResourceCollector,
+				podCIDRCollector,
+				releaseCollector,
+				tenantClientCollector,
 			},
 			Logger: config.Logger,
 		}
@@ -0,0 +1,115 @@
+package collector
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+)
+
+// breakerStates are the states of tenant API circuit breakers exported for
+// every tenant cluster, so that state changes show up as value changes.
+var breakerStates = []tenantclient.BreakerState{
+	tenantclient.BreakerClosed,
+	tenantclient.BreakerHalfOpen,
+	tenantclient.BreakerOpen,
+}
+
+var (
+	tenantClientBreakerState *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemTenantClient, "circuit_breaker_state"),
+		"State of the tenant API circuit breaker of the cluster.",
+		[]string{
+			"cluster_id",
+			"state",
+		},
+		nil,
+	)
+
+	tenantClientPoolSize *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemTenantClient, "pool_size"),
+		"Number of tenant clients currently pooled.",
+		nil,
+		nil,
+	)
+
+	tenantClientBuildDuration *prometheus.Desc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, subsystemTenantClient, "build_duration_seconds"),
+		"Time it took to build tenant clients which were not pooled or expired.",
+		nil,
+		nil,
+	)
+)
+
+type TenantClientConfig struct {
+	Logger       micrologger.Logger
+	TenantClient tenantclient.Interface
+}
+
+// TenantClient exposes statistics of the tenant client pool and the states of
+// the tenant API circuit breakers.
+type TenantClient struct {
+	logger       micrologger.Logger
+	tenantClient tenantclient.Interface
+}
+
+func NewTenantClient(config TenantClientConfig) (*TenantClient, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.TenantClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TenantClient must not be empty", config)
+	}
+
+	t := &TenantClient{
+		logger:       config.Logger,
+		tenantClient: config.TenantClient,
+	}
+
+	return t, nil
+}
+
+func (t *TenantClient) Collect(ch chan<- prometheus.Metric) error {
+	stats := t.tenantClient.Stats()
+
+	ch <- prometheus.MustNewConstMetric(
+		tenantClientPoolSize,
+		prometheus.GaugeValue,
+		float64(stats.Size),
+	)
+
+	ch <- prometheus.MustNewConstHistogram(
+		tenantClientBuildDuration,
+		stats.BuildCount,
+		stats.BuildSum,
+		stats.BuildBuckets,
+	)
+
+	for id, state := range stats.Breakers {
+		for _, s := range breakerStates {
+			var v float64
+			if s == state {
+				v = GaugeValue
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				tenantClientBreakerState,
+				prometheus.GaugeValue,
+				v,
+				id,
+				string(s),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (t *TenantClient) Describe(ch chan<- *prometheus.Desc) error {
+	ch <- tenantClientBreakerState
+	ch <- tenantClientPoolSize
+	ch <- tenantClientBuildDuration
+
+	return nil
+}
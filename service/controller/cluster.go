@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"time"
+
 	"github.com/giantswarm/apiextensions/v3/pkg/annotation"
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/certs/v3/pkg/certs"
@@ -25,50 +27,70 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/pkg/label"
 	"github.com/giantswarm/cluster-operator/v3/pkg/project"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/allocatepodcidr"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/app"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/appfinalizer"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/appversionlabel"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/certconfig"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/clusterconfigmap"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/clusterid"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/clusternetworkstatus"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/clusterstatus"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/cpnamespace"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deletecrs"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deleteinfrarefs"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deletiondeadline"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deletionprotection"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/encryptionkey"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/evicttenantclient"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/keepforcrs"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/keepforinfrarefs"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/kubeconfig"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/nodeinformer"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/pausedcondition"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/statuscondition"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/updateg8scontrolplanes"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/updateinfrarefs"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/updatemachinedeployments"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/wrapper/pausedresource"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/apiendpoint"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/hamaster"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/paused"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantinformer"
 )
 
 // ClusterConfig contains necessary dependencies and settings for CAPI's Cluster
 // CRD controller implementation.
 type ClusterConfig struct {
-	BaseDomain     basedomain.Interface
-	CertsSearcher  certs.Interface
-	Event          recorder.Interface
-	FileSystem     afero.Fs
-	K8sClient      k8sclient.Interface
-	Logger         micrologger.Logger
-	PodCIDR        podcidr.Interface
-	Tenant         tenantcluster.Interface
-	ReleaseVersion releaseversion.Interface
-
-	APIIP                      string
+	APIEndpoint      apiendpoint.Interface
+	BaseDomain       basedomain.Interface
+	CertsSearcher    certs.Interface
+	ClusterNetwork   clusternetwork.Interface
+	DeletionProgress deletionprogress.Interface
+	Event            recorder.Interface
+	FileSystem       afero.Fs
+	K8sClient        k8sclient.Interface
+	Logger           micrologger.Logger
+	Paused           paused.Interface
+	PodCIDR          podcidr.Interface
+	Tenant           tenantcluster.Interface
+	TenantClient     tenantclient.Interface
+	TenantInformer   tenantinformer.Interface
+	ReleaseVersion   releaseversion.Interface
+
 	CertTTL                    string
-	ClusterIPRange             string
-	DNSIP                      string
-	ClusterDomain              string
+	DegradedThreshold          time.Duration
+	DeletionTimeout            time.Duration
+	PausedStatusCollection     bool
+	StatusHistoryArchive       bool
+	StatusHistoryLimit         int
 	KiamWatchDogEnabled        bool
 	NewCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
 	Provider                   string
@@ -104,7 +126,7 @@ func NewCluster(config ClusterConfig) (*Cluster, error) {
 
 			// Name is used to compute finalizer names. This here results in something
 			// like operatorkit.giantswarm.io/cluster-operator-cluster-controller.
-			Name: project.Name() + "-cluster-controller",
+			Name: key.ClusterControllerName(),
 			Selector: labels.SelectorFromSet(map[string]string{
 				label.OperatorVersion: project.Version(),
 			}),
@@ -140,21 +162,6 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 		}
 	}
 
-	var tenantClient tenantclient.Interface
-	{
-		c := tenantclient.Config{
-			BaseDomain:    config.BaseDomain,
-			Logger:        config.Logger,
-			K8sClient:     config.K8sClient,
-			TenantCluster: config.Tenant,
-		}
-
-		tenantClient, err = tenantclient.New(c)
-		if err != nil {
-			return nil, microerror.Mask(err)
-		}
-	}
-
 	var appGetter appresource.StateGetter
 	{
 		c := app.Config{
@@ -231,16 +238,16 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 	var certConfigResource resource.Interface
 	{
 		c := certconfig.Config{
+			APIEndpoint:    config.APIEndpoint,
 			BaseDomain:     config.BaseDomain,
+			ClusterNetwork: config.ClusterNetwork,
 			G8sClient:      config.K8sClient.G8sClient(),
 			HAMaster:       haMaster,
 			Logger:         config.Logger,
 			ReleaseVersion: config.ReleaseVersion,
 
-			APIIP:         config.APIIP,
-			CertTTL:       config.CertTTL,
-			ClusterDomain: config.ClusterDomain,
-			Provider:      config.Provider,
+			CertTTL:  config.CertTTL,
+			Provider: config.Provider,
 		}
 
 		certConfigResource, err = certconfig.New(c)
@@ -252,14 +259,14 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 	var clusterConfigMapGetter configmapresource.StateGetter
 	{
 		c := clusterconfigmap.Config{
-			BaseDomain: config.BaseDomain,
-			K8sClient:  config.K8sClient.K8sClient(),
-			Logger:     config.Logger,
-			PodCIDR:    config.PodCIDR,
+			APIEndpoint:    config.APIEndpoint,
+			BaseDomain:     config.BaseDomain,
+			ClusterNetwork: config.ClusterNetwork,
+			K8sClient:      config.K8sClient.K8sClient(),
+			Logger:         config.Logger,
+			PodCIDR:        config.PodCIDR,
 
-			ClusterIPRange: config.ClusterIPRange,
-			DNSIP:          config.DNSIP,
-			Provider:       config.Provider,
+			Provider: config.Provider,
 		}
 
 		clusterConfigMapGetter, err = clusterconfigmap.New(c)
@@ -307,6 +314,35 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 		}
 	}
 
+	var allocatePodCIDRResource resource.Interface
+	{
+		c := allocatepodcidr.Config{
+			Event:   config.Event,
+			Logger:  config.Logger,
+			PodCIDR: config.PodCIDR,
+		}
+
+		allocatePodCIDRResource, err = allocatepodcidr.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var clusterNetworkStatusResource resource.Interface
+	{
+		c := clusternetworkstatus.Config{
+			ClusterNetwork: config.ClusterNetwork,
+			Event:          config.Event,
+			K8sClient:      config.K8sClient,
+			Logger:         config.Logger,
+		}
+
+		clusterNetworkStatusResource, err = clusternetworkstatus.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var clusterStatusResource resource.Interface
 	{
 		c := clusterstatus.Config{
@@ -322,6 +358,51 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 		}
 	}
 
+	var deletionProtectionResource resource.Interface
+	{
+		c := deletionprotection.Config{
+			Event:     config.Event,
+			K8sClient: config.K8sClient,
+			Logger:    config.Logger,
+		}
+
+		deletionProtectionResource, err = deletionprotection.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var deletionDeadlineResource resource.Interface
+	{
+		c := deletiondeadline.Config{
+			DeletionProgress: config.DeletionProgress,
+			Event:            config.Event,
+			K8sClient:        config.K8sClient,
+			Logger:           config.Logger,
+
+			DeletionTimeout: config.DeletionTimeout,
+		}
+
+		deletionDeadlineResource, err = deletiondeadline.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var pausedConditionResource resource.Interface
+	{
+		c := pausedcondition.Config{
+			Event:     config.Event,
+			K8sClient: config.K8sClient,
+			Logger:    config.Logger,
+		}
+
+		pausedConditionResource, err = pausedcondition.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var cpNamespaceResource resource.Interface
 	{
 		c := cpnamespace.Config{
@@ -472,7 +553,7 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 		}
 	}
 
-	var kubeConfigGetter secretresource.StateGetter
+	var kubeConfigGetter *kubeconfig.Resource
 	{
 		var tenantCluster tenantcluster.Interface
 		{
@@ -490,11 +571,13 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 		}
 
 		c := kubeconfig.Config{
-			BaseDomain:    config.BaseDomain,
+			APIEndpoint:   config.APIEndpoint,
 			CertsSearcher: config.CertsSearcher,
+			Event:         config.Event,
 			K8sClient:     config.K8sClient.K8sClient(),
 			Logger:        config.Logger,
 			Tenant:        tenantCluster,
+			TenantClient:  config.TenantClient,
 		}
 
 		kubeConfigGetter, err = kubeconfig.New(c)
@@ -518,23 +601,54 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 			return nil, microerror.Mask(err)
 		}
 
-		kubeConfigResource, err = toCRUDResource(config.Logger, ops)
+		kubeConfigResource, err = toCRUDResource(config.Logger, kubeConfigGetter.WrapCRUD(ops))
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
 	}
 
-	var statusConditionResource resource.Interface
+	var evictTenantClientResource resource.Interface
 	{
-		c := statuscondition.Config{
-			Event:          config.Event,
-			K8sClient:      config.K8sClient,
+		c := evicttenantclient.Config{
+			Logger:       config.Logger,
+			TenantClient: config.TenantClient,
+		}
+
+		evictTenantClientResource, err = evicttenantclient.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var nodeInformerResource resource.Interface
+	{
+		c := nodeinformer.Config{
 			Logger:         config.Logger,
-			ReleaseVersion: config.ReleaseVersion,
-			TenantClient:   tenantClient,
+			TenantInformer: config.TenantInformer,
+		}
 
+		nodeInformerResource, err = nodeinformer.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var statusConditionResource resource.Interface
+	{
+		c := statuscondition.Config{
+			DeletionProgress: config.DeletionProgress,
+			Event:            config.Event,
+			K8sClient:        config.K8sClient,
+			Logger:           config.Logger,
+			ReleaseVersion:   config.ReleaseVersion,
+			TenantClient:     config.TenantClient,
+			TenantInformer:   config.TenantInformer,
+
+			DegradedThreshold:          config.DegradedThreshold,
 			NewCommonClusterObjectFunc: config.NewCommonClusterObjectFunc,
 			Provider:                   config.Provider,
+			StatusHistoryArchive:       config.StatusHistoryArchive,
+			StatusHistoryLimit:         config.StatusHistoryLimit,
 		}
 
 		statusConditionResource, err = statuscondition.New(c)
@@ -587,9 +701,24 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 	}
 
 	resources := []resource.Interface{
+		// Following resource blocks the deletion of protected tenant clusters
+		// and must therefore be executed first.
+		deletionProtectionResource,
+
+		// Following resource releases finalizers of force deleted tenant
+		// clusters once their deletion exceeded the deletion timeout and must
+		// therefore be executed before any resource keeping finalizers.
+		deletionDeadlineResource,
+
+		// Following resource maintains the Paused condition and must therefore
+		// be executed before any resource skipped for paused tenant clusters.
+		pausedConditionResource,
+
 		// Following resources manage resources in the control plane.
 		cpNamespaceResource,
 		encryptionKeyResource,
+		allocatePodCIDRResource,
+		clusterNetworkStatusResource,
 		certConfigResource,
 		clusterConfigMapResource,
 		kubeConfigResource,
@@ -612,6 +741,8 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 		keepForG8sControlPlaneCRsResource,
 		keepForMachineDeploymentCRsResource,
 		keepForInfraRefsResource,
+		nodeInformerResource,
+		evictTenantClientResource,
 	}
 
 	// Wrap resources with retry and metrics.
@@ -634,6 +765,30 @@ func newClusterResources(config ClusterConfig) ([]resource.Interface, error) {
 		}
 	}
 
+	// Wrap resources with paused resources, so that they are skipped for
+	// paused tenant clusters. Status resources keep being executed as long as
+	// status collection of paused tenant clusters is enabled.
+	{
+		ignored := []string{
+			pausedcondition.Name,
+		}
+		if config.PausedStatusCollection {
+			ignored = append(ignored, clusterid.Name, clusterstatus.Name, statuscondition.Name)
+		}
+
+		c := pausedresource.WrapConfig{
+			Logger: config.Logger,
+			Paused: config.Paused,
+
+			Ignored: ignored,
+		}
+
+		resources, err = pausedresource.Wrap(resources, c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	return resources, nil
 }
 
@@ -0,0 +1,220 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/certs/v3/pkg/certs"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/giantswarm/tenantcluster/v4/pkg/tenantcluster"
+	"github.com/spf13/afero"
+
+	"github.com/giantswarm/cluster-operator/v3/service/deletionplan"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/apiendpoint"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/paused"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
+	tenantclientunittest "github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient/unittest"
+	tenantinformerunittest "github.com/giantswarm/cluster-operator/v3/service/internal/tenantinformer/unittest"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
+)
+
+// Test_newClusterResources_deletionPlan ensures the deletion plan orders its
+// steps the same way the resources of the cluster controller execute them.
+// It fails when the order of the deleting resources changes without the
+// deletion plan being adjusted.
+func Test_newClusterResources_deletionPlan(t *testing.T) {
+	resources, err := newClusterResources(newTestClusterConfig(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	planned := map[string]bool{}
+	for _, r := range deletionplan.Resources() {
+		planned[r] = true
+	}
+
+	var names []string
+	for _, r := range resources {
+		if planned[r.Name()] {
+			names = append(names, r.Name())
+		}
+	}
+
+	if !reflect.DeepEqual(names, deletionplan.Resources()) {
+		t.Fatalf("expected %#v to be equal to %#v", deletionplan.Resources(), names)
+	}
+}
+
+func newTestClusterConfig(t *testing.T) ClusterConfig {
+	var err error
+
+	k8sClient := unittest.FakeK8sClient()
+	logger := microloggertest.New()
+
+	var certsSearcher certs.Interface
+	{
+		c := certs.Config{
+			K8sClient: k8sClient.K8sClient(),
+			Logger:    logger,
+
+			WatchTimeout: 5 * time.Second,
+		}
+
+		certsSearcher, err = certs.NewSearcher(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var tenantCluster tenantcluster.Interface
+	{
+		c := tenantcluster.Config{
+			CertsSearcher: certsSearcher,
+			Logger:        logger,
+
+			CertID: certs.ClusterOperatorAPICert,
+		}
+
+		tenantCluster, err = tenantcluster.New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var pc podcidr.Interface
+	{
+		c := podcidr.Config{
+			K8sClient: k8sClient,
+
+			InstallationCIDR:        "192.168.0.0/16",
+			InstallationServiceCIDR: "172.31.0.0/16",
+			Pool:                    "10.2.0.0/16",
+			PoolPrefixLength:        24,
+		}
+
+		pc, err = podcidr.New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var cn clusternetwork.Interface
+	{
+		c := clusternetwork.Config{
+			K8sClient: k8sClient,
+			PodCIDR:   pc,
+
+			InstallationClusterDomain:  "cluster.local",
+			InstallationClusterIPRange: "172.31.0.0/16",
+		}
+
+		cn, err = clusternetwork.New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var bd basedomain.Interface
+	{
+		c := basedomain.Config{
+			K8sClient: k8sClient,
+		}
+
+		bd, err = basedomain.New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var ae apiendpoint.Interface
+	{
+		c := apiendpoint.Config{
+			BaseDomain: bd,
+			K8sClient:  k8sClient,
+		}
+
+		ae, err = apiendpoint.New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var rv releaseversion.Interface
+	{
+		c := releaseversion.Config{
+			K8sClient: k8sClient,
+
+			CreationTimeout: time.Hour,
+			UpdateTimeout:   time.Hour,
+		}
+
+		rv, err = releaseversion.New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var dp deletionprogress.Interface
+	{
+		c := deletionprogress.Config{
+			K8sClient: k8sClient,
+			Logger:    logger,
+		}
+
+		dp, err = deletionprogress.New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var p paused.Interface
+	{
+		c := paused.Config{
+			K8sClient: k8sClient,
+		}
+
+		p, err = paused.New(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := ClusterConfig{
+		APIEndpoint:      ae,
+		BaseDomain:       bd,
+		CertsSearcher:    certsSearcher,
+		ClusterNetwork:   cn,
+		DeletionProgress: dp,
+		Event:            recorder.New(recorder.Config{K8sClient: k8sClient, Component: "cluster-operator"}),
+		FileSystem:       afero.NewMemMapFs(),
+		K8sClient:        k8sClient,
+		Logger:           logger,
+		Paused:           p,
+		PodCIDR:          pc,
+		Tenant:           tenantCluster,
+		TenantClient:     tenantclientunittest.FakeTenantClient(k8sClient),
+		TenantInformer:   tenantinformerunittest.FakeTenantInformer(k8sClient),
+		ReleaseVersion:   rv,
+
+		CertTTL:            "720h",
+		DegradedThreshold:  5 * time.Minute,
+		DeletionTimeout:    time.Hour,
+		StatusHistoryLimit: 10,
+		NewCommonClusterObjectFunc: func() infrastructurev1alpha3.CommonClusterObject {
+			return new(infrastructurev1alpha3.AWSCluster)
+		},
+		Provider:             "aws",
+		RawAppDefaultConfig:  "{}",
+		RawAppOverrideConfig: "{}",
+		RegistryDomain:       "quay.io",
+	}
+
+	return c
+}
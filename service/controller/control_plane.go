@@ -21,8 +21,10 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deleteinfrarefs"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/keepforinfrarefs"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/updateinfrarefs"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/wrapper/pausedresource"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/paused"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 )
@@ -35,10 +37,15 @@ type ControlPlaneConfig struct {
 	K8sClient      k8sclient.Interface
 	Logger         micrologger.Logger
 	NodeCount      nodecount.Interface
+	Paused         paused.Interface
 	Tenant         tenantcluster.Interface
 	ReleaseVersion releaseversion.Interface
 
-	Provider string
+	// PausedStatusCollection keeps status resources executing for paused
+	// tenant clusters.
+	PausedStatusCollection     bool
+	NewCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	Provider                   string
 }
 
 type ControlPlane struct {
@@ -68,7 +75,7 @@ func NewControlPlane(config ControlPlaneConfig) (*ControlPlane, error) {
 
 			// Name is used to compute finalizer names. This here results in something
 			// like operatorkit.giantswarm.io/cluster-operator-control-plane-controller.
-			Name: project.Name() + "-control-plane-controller",
+			Name: key.ControlPlaneControllerName(),
 			Selector: labels.SelectorFromSet(map[string]string{
 				label.OperatorVersion: project.Version(),
 			}),
@@ -93,10 +100,14 @@ func newControlPlaneResources(config ControlPlaneConfig) ([]resource.Interface,
 	var controlPlaneStatusResource resource.Interface
 	{
 		c := controlplanestatus.Config{
-			Event:     config.Event,
-			K8sClient: config.K8sClient,
-			Logger:    config.Logger,
-			NodeCount: config.NodeCount,
+			Event:          config.Event,
+			K8sClient:      config.K8sClient,
+			Logger:         config.Logger,
+			NodeCount:      config.NodeCount,
+			ReleaseVersion: config.ReleaseVersion,
+
+			NewCommonClusterObjectFunc: config.NewCommonClusterObjectFunc,
+			Provider:                   config.Provider,
 		}
 
 		controlPlaneStatusResource, err = controlplanestatus.New(c)
@@ -185,6 +196,28 @@ func newControlPlaneResources(config ControlPlaneConfig) ([]resource.Interface,
 		}
 	}
 
+	// Wrap resources with paused resources, so that they are skipped for
+	// paused tenant clusters. Status resources keep being executed as long as
+	// status collection of paused tenant clusters is enabled.
+	{
+		var ignored []string
+		if config.PausedStatusCollection {
+			ignored = append(ignored, controlplanestatus.Name)
+		}
+
+		c := pausedresource.WrapConfig{
+			Logger: config.Logger,
+			Paused: config.Paused,
+
+			Ignored: ignored,
+		}
+
+		resources, err = pausedresource.Wrap(resources, c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	return resources, nil
 }
 
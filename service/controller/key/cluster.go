@@ -11,8 +11,14 @@ func APIEndpoint(getter LabelsGetter, base string) string {
 	return fmt.Sprintf("api.%s.k8s.%s", ClusterID(getter), base)
 }
 
-func KubeConfigEndpoint(getter LabelsGetter, base string) string {
-	return fmt.Sprintf("https://%s", APIEndpoint(getter, base))
+func InternalAPIEndpoint(getter LabelsGetter, base string) string {
+	return fmt.Sprintf("internal-api.%s.k8s.%s", ClusterID(getter), base)
+}
+
+// KubeConfigEndpoint returns the server URL used in kubeconfigs for the given
+// API endpoint as selected per tenant cluster.
+func KubeConfigEndpoint(apiEndpoint string) string {
+	return fmt.Sprintf("https://%s", apiEndpoint)
 }
 
 func TenantEndpoint(getter LabelsGetter, base string) string {
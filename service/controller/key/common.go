@@ -3,23 +3,77 @@ package key
 import (
 	"fmt"
 
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
 	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
 )
 
+// ClusterControllerName returns the name of the controller reconciling CAPI's
+// Cluster CRs.
+func ClusterControllerName() string {
+	return project.Name() + "-cluster-controller"
+}
+
 // ClusterConfigMapName returns the cluster name used in the configMap
 // generated for this tenant cluster.
 func ClusterConfigMapName(getter LabelsGetter) string {
 	return fmt.Sprintf("%s-cluster-values", ClusterID(getter))
 }
 
+// StatusHistoryConfigMapName returns the name of the configMap archiving the
+// status conditions and versions trimmed from the infrastructure cluster CR of
+// this tenant cluster.
+func StatusHistoryConfigMapName(getter LabelsGetter) string {
+	return fmt.Sprintf("%s-status-history", ClusterID(getter))
+}
+
+// ControlPlaneControllerName returns the name of the controller reconciling
+// G8sControlPlane CRs.
+func ControlPlaneControllerName() string {
+	return project.Name() + "-control-plane-controller"
+}
+
+// EncryptionKeySecretName returns the name of the secret holding the
+// encryption key of this tenant cluster.
+func EncryptionKeySecretName(getter LabelsGetter) string {
+	return fmt.Sprintf("%s-encryption", ClusterID(getter))
+}
+
 func ClusterID(getter LabelsGetter) string {
 	return getter.GetLabels()[label.Cluster]
 }
 
+// Finalizer returns the finalizer operatorkit manages on behalf of the
+// controller of the given name.
+func Finalizer(controllerName string) string {
+	return fmt.Sprintf("operatorkit.giantswarm.io/%s", controllerName)
+}
+
 func IsDeleted(getter DeletionTimestampGetter) bool {
 	return getter.GetDeletionTimestamp() != nil
 }
 
+// IsDeletionProtected returns true when the deletion protection annotation is
+// present, regardless of its value.
+func IsDeletionProtected(getter AnnotationsGetter) bool {
+	_, ok := getter.GetAnnotations()[annotation.DeletionProtection]
+	return ok
+}
+
+// IsHibernated returns true when the hibernation annotation is present,
+// regardless of its value.
+func IsHibernated(getter AnnotationsGetter) bool {
+	_, ok := getter.GetAnnotations()[annotation.Hibernate]
+	return ok
+}
+
+// IsForceDeleted returns true when the force delete annotation is present,
+// regardless of its value.
+func IsForceDeleted(getter AnnotationsGetter) bool {
+	_, ok := getter.GetAnnotations()[annotation.ForceDelete]
+	return ok
+}
+
 func KubeConfigClusterName(getter LabelsGetter) string {
 	return fmt.Sprintf("giantswarm-%s", ClusterID(getter))
 }
@@ -32,6 +86,18 @@ func MachineDeployment(getter LabelsGetter) string {
 	return getter.GetLabels()[label.MachineDeployment]
 }
 
+// MachineDeploymentControllerName returns the name of the controller
+// reconciling CAPI's MachineDeployment CRs.
+func MachineDeploymentControllerName() string {
+	return project.Name() + "-machine-deployment-controller"
+}
+
+// OrphanedResourcesConfigMapName returns the name of the configMap recording
+// the resources orphaned by the forced deletion of this tenant cluster.
+func OrphanedResourcesConfigMapName(getter LabelsGetter) string {
+	return fmt.Sprintf("%s-orphaned-resources", ClusterID(getter))
+}
+
 func OperatorVersion(getter LabelsGetter) string {
 	return getter.GetLabels()[label.OperatorVersion]
 }
@@ -0,0 +1,34 @@
+package key
+
+import (
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+// ConditionsEqual compares the given conditions ignoring their transition
+// times. It is used to only update the status of a CR when the state of a
+// condition actually changed.
+func ConditionsEqual(a, b apiv1alpha3.Conditions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Type != b[i].Type {
+			return false
+		}
+		if a[i].Status != b[i].Status {
+			return false
+		}
+		if a[i].Severity != b[i].Severity {
+			return false
+		}
+		if a[i].Reason != b[i].Reason {
+			return false
+		}
+		if a[i].Message != b[i].Message {
+			return false
+		}
+	}
+
+	return true
+}
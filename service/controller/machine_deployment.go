@@ -1,6 +1,9 @@
 package controller
 
 import (
+	"time"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
@@ -18,13 +21,17 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/pkg/project"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deleteinfrarefs"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/drainnodes"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/keepforinfrarefs"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/machinedeploymentstatus"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/updateinfrarefs"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/wrapper/pausedresource"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/paused"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
 )
 
 type MachineDeploymentConfig struct {
@@ -33,10 +40,19 @@ type MachineDeploymentConfig struct {
 	K8sClient      k8sclient.Interface
 	Logger         micrologger.Logger
 	NodeCount      nodecount.Interface
+	Paused         paused.Interface
 	Tenant         tenantcluster.Interface
+	TenantClient   tenantclient.Interface
 	ReleaseVersion releaseversion.Interface
 
-	Provider string
+	// DrainTimeout is the duration after which draining the nodes of a deleted
+	// node pool is given up on.
+	DrainTimeout time.Duration
+	// PausedStatusCollection keeps status resources executing for paused
+	// tenant clusters.
+	PausedStatusCollection     bool
+	NewCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	Provider                   string
 }
 
 type MachineDeployment struct {
@@ -66,7 +82,7 @@ func NewMachineDeployment(config MachineDeploymentConfig) (*MachineDeployment, e
 
 			// Name is used to compute finalizer names. This here results in something
 			// like operatorkit.giantswarm.io/cluster-operator-machine-deployment-controller.
-			Name: project.Name() + "-machine-deployment-controller",
+			Name: key.MachineDeploymentControllerName(),
 			Selector: labels.SelectorFromSet(map[string]string{
 				label.OperatorVersion: project.Version(),
 			}),
@@ -103,6 +119,23 @@ func newMachineDeploymentResources(config MachineDeploymentConfig) ([]resource.I
 		}
 	}
 
+	var drainNodesResource resource.Interface
+	{
+		c := drainnodes.Config{
+			Event:        config.Event,
+			K8sClient:    config.K8sClient,
+			Logger:       config.Logger,
+			TenantClient: config.TenantClient,
+
+			DrainTimeout: config.DrainTimeout,
+		}
+
+		drainNodesResource, err = drainnodes.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var keepForInfraRefsResource resource.Interface
 	{
 		c := keepforinfrarefs.Config{
@@ -121,10 +154,14 @@ func newMachineDeploymentResources(config MachineDeploymentConfig) ([]resource.I
 	var machineDeploymentStatusResource resource.Interface
 	{
 		c := machinedeploymentstatus.Config{
-			Event:     config.Event,
-			K8sClient: config.K8sClient,
-			Logger:    config.Logger,
-			NodeCount: config.NodeCount,
+			Event:          config.Event,
+			K8sClient:      config.K8sClient,
+			Logger:         config.Logger,
+			NodeCount:      config.NodeCount,
+			ReleaseVersion: config.ReleaseVersion,
+
+			NewCommonClusterObjectFunc: config.NewCommonClusterObjectFunc,
+			Provider:                   config.Provider,
 		}
 
 		machineDeploymentStatusResource, err = machinedeploymentstatus.New(c)
@@ -157,7 +194,11 @@ func newMachineDeploymentResources(config MachineDeploymentConfig) ([]resource.I
 		// finalizers where machineDeploymentStatusResource does not.
 		machineDeploymentStatusResource,
 
-		// Following resources manage resources in the control plane.
+		// Following resources manage resources in the control plane. Note that
+		// drainNodesResource needs to run before deleteInfraRefsResource
+		// because the infrastructure reference must only be deleted once the
+		// nodes of the node pool are drained.
+		drainNodesResource,
 		deleteInfraRefsResource,
 		keepForInfraRefsResource,
 		updateInfraRefsResource,
@@ -182,6 +223,28 @@ func newMachineDeploymentResources(config MachineDeploymentConfig) ([]resource.I
 		}
 	}
 
+	// Wrap resources with paused resources, so that they are skipped for
+	// paused tenant clusters. Status resources keep being executed as long as
+	// status collection of paused tenant clusters is enabled.
+	{
+		var ignored []string
+		if config.PausedStatusCollection {
+			ignored = append(ignored, machinedeploymentstatus.Name)
+		}
+
+		c := pausedresource.WrapConfig{
+			Logger: config.Logger,
+			Paused: config.Paused,
+
+			Ignored: ignored,
+		}
+
+		resources, err = pausedresource.Wrap(resources, c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	return resources, nil
 }
 
@@ -0,0 +1,93 @@
+package allocatepodcidr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
+)
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCluster(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	{
+		r.logger.Debugf(ctx, "allocating pod CIDR")
+
+		cidr, err := r.podCIDR.Allocate(ctx, &cr)
+		if podcidr.IsPoolExhausted(err) {
+			r.logger.Debugf(ctx, "did not allocate pod CIDR: %s", err)
+			r.event.EmitWarning(ctx, &cr, "PodCIDRPoolExhausted", err.Error())
+
+			r.logger.Debugf(ctx, "canceling reconciliation")
+			reconciliationcanceledcontext.SetCanceled(ctx)
+			return nil
+
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if cidr != "" {
+			r.logger.Debugf(ctx, "allocated pod CIDR %#q", cidr)
+			r.event.Emit(ctx, &cr, "PodCIDRAllocated", fmt.Sprintf("allocated pod CIDR %s", cidr))
+
+			// Everything computed from the pod CIDR further down has to see the
+			// allocated CIDR, so we start over with the next reconciliation.
+			r.logger.Debugf(ctx, "canceling reconciliation")
+			reconciliationcanceledcontext.SetCanceled(ctx)
+			return nil
+		}
+
+		r.logger.Debugf(ctx, "did not allocate pod CIDR")
+	}
+
+	{
+		r.logger.Debugf(ctx, "checking pod CIDR conflicts")
+
+		conflicts, err := r.podCIDR.Conflicts(ctx)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		var others []string
+		var service bool
+		for _, id := range conflicts[key.ClusterID(&cr)] {
+			if id == key.ClusterID(&cr) {
+				service = true
+			} else {
+				others = append(others, id)
+			}
+		}
+
+		var overlaps []string
+		if service {
+			overlaps = append(overlaps, "the service CIDR of the tenant cluster")
+		}
+		if len(others) > 0 {
+			overlaps = append(overlaps, fmt.Sprintf("the pod CIDRs of tenant clusters %s", strings.Join(others, ", ")))
+		}
+
+		message := strings.Join(overlaps, " and ")
+		if message != "" {
+			r.logger.Debugf(ctx, "pod CIDR overlaps with %s", message)
+		}
+
+		// The warning is only emitted when the conflicts of the tenant cluster
+		// change, so that a lasting conflict does not cause an event on
+		// every reconciliation.
+		if r.conflictsChanged(key.ClusterID(&cr), message) && message != "" {
+			r.event.EmitWarning(ctx, &cr, "PodCIDRConflict", fmt.Sprintf("pod CIDR overlaps with %s", message))
+		}
+
+		r.logger.Debugf(ctx, "checked pod CIDR conflicts")
+	}
+
+	return nil
+}
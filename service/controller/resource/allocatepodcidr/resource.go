@@ -0,0 +1,80 @@
+package allocatepodcidr
+
+import (
+	"sync"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+)
+
+const (
+	Name = "allocatepodcidr"
+)
+
+type Config struct {
+	Event   recorder.Interface
+	Logger  micrologger.Logger
+	PodCIDR podcidr.Interface
+}
+
+// Resource allocates a pod CIDR for Tenant Clusters not specifying one on
+// their own and reports pod CIDRs overlapping with other Tenant Clusters.
+type Resource struct {
+	event   recorder.Interface
+	logger  micrologger.Logger
+	podCIDR podcidr.Interface
+
+	mutex sync.Mutex
+	// conflicts holds the description of the pod CIDR conflicts last reported
+	// per cluster ID.
+	conflicts map[string]string
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Event == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Event must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.PodCIDR == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.PodCIDR must not be empty", config)
+	}
+
+	r := &Resource{
+		event:   config.Event,
+		logger:  config.Logger,
+		podCIDR: config.PodCIDR,
+
+		conflicts: map[string]string{},
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
+
+// conflictsChanged records the given description of the pod CIDR conflicts of
+// the given tenant cluster and returns true when it differs from the one
+// recorded before.
+func (r *Resource) conflictsChanged(clusterID string, conflicts string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.conflicts[clusterID] == conflicts {
+		return false
+	}
+
+	if conflicts == "" {
+		delete(r.conflicts, clusterID)
+	} else {
+		r.conflicts[clusterID] = conflicts
+	}
+
+	return true
+}
@@ -24,7 +24,7 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 	// We keep the finalizer for the app-operator app CR so the resources in
 	// the management cluster are deleted.
 	o := metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("%s!=%s", label.AppKubernetesName, "app-operator"),
+		LabelSelector: fmt.Sprintf("%s!=%s", label.AppKubernetesName, KeptApp),
 	}
 
 	r.logger.Debugf(ctx, "finding apps to remove finalizers for")
@@ -68,7 +68,7 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 
 func getFinalizerIndex(finalizers []string) int {
 	for i, f := range finalizers {
-		if f == "operatorkit.giantswarm.io/app-operator-app" {
+		if f == Finalizer {
 			return i
 		}
 	}
@@ -8,6 +8,13 @@ import (
 
 const (
 	Name = "appfinalizer"
+
+	// Finalizer is the finalizer of app-operator removed from the App CRs of
+	// deleted tenant clusters.
+	Finalizer = "operatorkit.giantswarm.io/app-operator-app"
+	// KeptApp is the name of the app whose App CR keeps its finalizer, so that
+	// its resources in the management cluster get deleted.
+	KeptApp = "app-operator"
 )
 
 type Config struct {
@@ -13,6 +13,7 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/pkg/label"
 	"github.com/giantswarm/cluster-operator/v3/pkg/project"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/hamaster"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 )
@@ -55,6 +56,14 @@ func (r *Resource) getDesiredState(ctx context.Context, obj interface{}) (interf
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
+	endpoint, err := r.apiEndpoint.APIEndpoint(ctx, &cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	network, err := r.clusterNetwork.ClusterNetwork(ctx, &cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
 	componentVersions, err := r.releaseVersion.ComponentVersion(ctx, &cr)
 	if err != nil {
 		return nil, microerror.Mask(err)
@@ -68,7 +77,7 @@ func (r *Resource) getDesiredState(ctx context.Context, obj interface{}) (interf
 
 	var certConfigs []*corev1alpha1.CertConfig
 	{
-		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForAPI(ctx, bd, cr)))
+		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForAPI(ctx, bd, endpoint, network, cr)))
 		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForAppOperator(ctx, bd, cr)))
 		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForAWSOperator(ctx, bd, cr)))
 		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForCalico(ctx, bd, cr)))
@@ -77,7 +86,7 @@ func (r *Resource) getDesiredState(ctx context.Context, obj interface{}) (interf
 		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForPrometheus(ctx, bd, cr)))
 		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForPrometheusEtcdClient(ctx, bd, cr)))
 		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForServiceAccount(ctx, bd, cr)))
-		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForWorker(ctx, bd, cr)))
+		certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForWorker(ctx, bd, network, cr)))
 
 		if haMasterEnabled {
 			certConfigs = append(certConfigs, newCertConfig(certOperatorVersion, cr, r.newSpecForEtcd1(ctx, bd, cr)))
@@ -118,20 +127,27 @@ func newCertConfig(certOperatorVersion string, cr apiv1alpha3.Cluster, cert core
 	}
 }
 
-func (r *Resource) newSpecForAPI(ctx context.Context, bd string, cr apiv1alpha3.Cluster) corev1alpha1.CertConfigSpecCert {
-	defaultAltNames := key.CertDefaultAltNames(r.clusterDomain)
+func (r *Resource) newSpecForAPI(ctx context.Context, bd string, endpoint string, network clusternetwork.Network, cr apiv1alpha3.Cluster) corev1alpha1.CertConfigSpecCert {
+	defaultAltNames := key.CertDefaultAltNames(network.ClusterDomain)
 	desiredAltNames := append(defaultAltNames,
 		fmt.Sprintf("master.%s", key.ClusterID(&cr)),
-		fmt.Sprintf("internal-api.%s.k8s.%s", key.ClusterID(&cr), bd),
+		key.InternalAPIEndpoint(&cr, bd),
 	)
 
+	// A custom API endpoint selected for the tenant cluster must be covered by
+	// the API certificate as well. The public and internal endpoints are
+	// already covered by the common name and the default alt names.
+	if endpoint != key.APIEndpoint(&cr, bd) && endpoint != key.InternalAPIEndpoint(&cr, bd) {
+		desiredAltNames = append(desiredAltNames, endpoint)
+	}
+
 	return corev1alpha1.CertConfigSpecCert{
 		AllowBareDomains: true,
 		AltNames:         desiredAltNames,
 		ClusterComponent: certs.APICert.String(),
 		ClusterID:        key.ClusterID(&cr),
-		CommonName:       fmt.Sprintf("api.%s.k8s.%s", key.ClusterID(&cr), bd),
-		IPSANs:           []string{r.apiIP, key.LocalhostIP},
+		CommonName:       key.APIEndpoint(&cr, bd),
+		IPSANs:           []string{network.APIIP, key.LocalhostIP},
 		Organizations:    []string{"system:masters"},
 		TTL:              r.certTTL,
 	}
@@ -305,10 +321,10 @@ func (r *Resource) newSpecForServiceAccount(ctx context.Context, bd string, cr a
 	}
 }
 
-func (r *Resource) newSpecForWorker(ctx context.Context, bd string, cr apiv1alpha3.Cluster) corev1alpha1.CertConfigSpecCert {
+func (r *Resource) newSpecForWorker(ctx context.Context, bd string, network clusternetwork.Network, cr apiv1alpha3.Cluster) corev1alpha1.CertConfigSpecCert {
 	return corev1alpha1.CertConfigSpecCert{
 		AllowBareDomains: true,
-		AltNames:         key.CertDefaultAltNames(r.clusterDomain),
+		AltNames:         key.CertDefaultAltNames(network.ClusterDomain),
 		ClusterComponent: certs.WorkerCert.String(),
 		ClusterID:        key.ClusterID(&cr),
 		CommonName:       fmt.Sprintf("worker.%s.k8s.%s", key.ClusterID(&cr), bd),
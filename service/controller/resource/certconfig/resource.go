@@ -1,13 +1,17 @@
 package certconfig
 
 import (
+	"reflect"
+
 	"github.com/giantswarm/apiextensions/v3/pkg/apis/core/v1alpha1"
 	"github.com/giantswarm/apiextensions/v3/pkg/clientset/versioned"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/apiendpoint"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/hamaster"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 )
@@ -26,37 +30,43 @@ const (
 
 // Config represents the configuration used to create a new cloud config resource.
 type Config struct {
+	APIEndpoint    apiendpoint.Interface
 	BaseDomain     basedomain.Interface
+	ClusterNetwork clusternetwork.Interface
 	G8sClient      versioned.Interface
 	HAMaster       hamaster.Interface
 	Logger         micrologger.Logger
 	ReleaseVersion releaseversion.Interface
 
-	APIIP         string
-	CertTTL       string
-	ClusterDomain string
-	Provider      string
+	CertTTL  string
+	Provider string
 }
 
 // Resource implements the cloud config resource.
 type Resource struct {
+	apiEndpoint    apiendpoint.Interface
 	baseDomain     basedomain.Interface
+	clusterNetwork clusternetwork.Interface
 	g8sClient      versioned.Interface
 	haMaster       hamaster.Interface
 	logger         micrologger.Logger
 	releaseVersion releaseversion.Interface
 
-	apiIP         string
-	certTTL       string
-	clusterDomain string
-	provider      string
+	certTTL  string
+	provider string
 }
 
 // New creates a new configured cloud config resource.
 func New(config Config) (*Resource, error) {
+	if config.APIEndpoint == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.APIEndpoint must not be empty", config)
+	}
 	if config.BaseDomain == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.BaseDomain must not be empty", config)
 	}
+	if config.ClusterNetwork == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ClusterNetwork must not be empty", config)
+	}
 	if config.G8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.G8sClient must not be empty", config)
 	}
@@ -70,30 +80,24 @@ func New(config Config) (*Resource, error) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.ReleaseVersion must not be empty", config)
 	}
 
-	if config.APIIP == "" {
-		return nil, microerror.Maskf(invalidConfigError, "%T.APIIP must not be empty", config)
-	}
 	if config.CertTTL == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CertTTL must not be empty", config)
 	}
-	if config.ClusterDomain == "" {
-		return nil, microerror.Maskf(invalidConfigError, "%T.ClusterDomain must not be empty", config)
-	}
 	if config.Provider == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Provider must not be empty", config)
 	}
 
 	r := &Resource{
+		apiEndpoint:    config.APIEndpoint,
 		baseDomain:     config.BaseDomain,
+		clusterNetwork: config.ClusterNetwork,
 		g8sClient:      config.G8sClient,
 		haMaster:       config.HAMaster,
 		logger:         config.Logger,
 		releaseVersion: config.ReleaseVersion,
 
-		apiIP:         config.APIIP,
-		certTTL:       config.CertTTL,
-		clusterDomain: config.ClusterDomain,
-		provider:      config.Provider,
+		certTTL:  config.CertTTL,
+		provider: config.Provider,
 	}
 
 	return r, nil
@@ -127,7 +131,16 @@ func getCertConfigByName(list []*v1alpha1.CertConfig, name string) (*v1alpha1.Ce
 func isCertConfigModified(a, b *v1alpha1.CertConfig) bool {
 	aVersion := key.CertConfigCertOperatorVersion(*a)
 	bVersion := key.CertConfigCertOperatorVersion(*b)
-	return aVersion != bVersion
+	if aVersion != bVersion {
+		return true
+	}
+
+	// The SANs depend on per cluster settings like the selected API endpoint
+	// and must be propagated to cert-operator when these settings change.
+	altNamesChanged := !reflect.DeepEqual(a.Spec.Cert.AltNames, b.Spec.Cert.AltNames)
+	ipSANsChanged := !reflect.DeepEqual(a.Spec.Cert.IPSANs, b.Spec.Cert.IPSANs)
+
+	return altNamesChanged || ipSANsChanged
 }
 
 func toCertConfigs(v interface{}) ([]*v1alpha1.CertConfig, error) {
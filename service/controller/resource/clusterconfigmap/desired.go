@@ -15,6 +15,7 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/pkg/label"
 	"github.com/giantswarm/cluster-operator/v3/pkg/project"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
 )
 
 func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) ([]*corev1.ConfigMap, error) {
@@ -27,6 +28,19 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) ([]*cor
 		return nil, microerror.Mask(err)
 	}
 
+	endpoint, err := r.apiEndpoint.APIEndpoint(ctx, &cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var network clusternetwork.Network
+	{
+		network, err = r.clusterNetwork.ClusterNetwork(ctx, &cr)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var podCIDR string
 	{
 		podCIDR, err = r.podCIDR.PodCIDR(ctx, &cr)
@@ -55,14 +69,16 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) ([]*cor
 					},
 					"kubernetes": map[string]interface{}{
 						"API": map[string]interface{}{
-							"clusterIPRange": r.clusterIPRange,
+							"clusterIPRange": network.ClusterIPRange,
+							"endpoint":       endpoint,
 						},
 						"DNS": map[string]interface{}{
-							"IP": r.dnsIP,
+							"IP": network.DNSIP,
 						},
+						"clusterDomain": network.ClusterDomain,
 					},
 				},
-				"clusterDNSIP": r.dnsIP,
+				"clusterDNSIP": network.DNSIP,
 				"clusterID":    key.ClusterID(&cr),
 			},
 		},
@@ -5,7 +5,9 @@ import (
 	"github.com/giantswarm/micrologger"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/giantswarm/cluster-operator/v3/service/internal/apiendpoint"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
 )
 
@@ -17,26 +19,26 @@ const (
 // Config represents the configuration used to create a new clusterConfigMap
 // resource.
 type Config struct {
-	BaseDomain basedomain.Interface
-	K8sClient  kubernetes.Interface
-	Logger     micrologger.Logger
-	PodCIDR    podcidr.Interface
+	APIEndpoint    apiendpoint.Interface
+	BaseDomain     basedomain.Interface
+	ClusterNetwork clusternetwork.Interface
+	K8sClient      kubernetes.Interface
+	Logger         micrologger.Logger
+	PodCIDR        podcidr.Interface
 
-	ClusterIPRange string
-	DNSIP          string
-	Provider       string
+	Provider string
 }
 
 // Resource implements the clusterConfigMap resource.
 type Resource struct {
-	baseDomain basedomain.Interface
-	k8sClient  kubernetes.Interface
-	logger     micrologger.Logger
-	podCIDR    podcidr.Interface
+	apiEndpoint    apiendpoint.Interface
+	baseDomain     basedomain.Interface
+	clusterNetwork clusternetwork.Interface
+	k8sClient      kubernetes.Interface
+	logger         micrologger.Logger
+	podCIDR        podcidr.Interface
 
-	clusterIPRange string
-	dnsIP          string
-	provider       string
+	provider string
 }
 
 // New creates a new configured config map state getter resource managing
@@ -45,9 +47,15 @@ type Resource struct {
 //     https://pkg.go.dev/github.com/giantswarm/operatorkit/v5/pkg/resource/k8s/secretresource#StateGetter
 //
 func New(config Config) (*Resource, error) {
+	if config.APIEndpoint == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.APIEndpoint must not be empty", config)
+	}
 	if config.BaseDomain == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
 	}
+	if config.ClusterNetwork == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ClusterNetwork must not be empty", config)
+	}
 	if config.K8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
 	}
@@ -58,25 +66,19 @@ func New(config Config) (*Resource, error) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.PodCIDR must not be empty", config)
 	}
 
-	if config.ClusterIPRange == "" {
-		return nil, microerror.Maskf(invalidConfigError, "%T.ClusterIPRange must not be empty", config)
-	}
-	if config.DNSIP == "" {
-		return nil, microerror.Maskf(invalidConfigError, "%T.DNSIP must not be empty", config)
-	}
 	if config.Provider == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Provider must not be empty", config)
 	}
 
 	r := &Resource{
-		baseDomain: config.BaseDomain,
-		k8sClient:  config.K8sClient,
-		logger:     config.Logger,
-		podCIDR:    config.PodCIDR,
+		apiEndpoint:    config.APIEndpoint,
+		baseDomain:     config.BaseDomain,
+		clusterNetwork: config.ClusterNetwork,
+		k8sClient:      config.K8sClient,
+		logger:         config.Logger,
+		podCIDR:        config.PodCIDR,
 
-		clusterIPRange: config.ClusterIPRange,
-		dnsIP:          config.DNSIP,
-		provider:       config.Provider,
+		provider: config.Provider,
 	}
 
 	return r, nil
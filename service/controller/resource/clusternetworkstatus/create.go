@@ -0,0 +1,74 @@
+package clusternetworkstatus
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
+)
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	var cr apiv1alpha3.Cluster
+	{
+		r.logger.Debugf(ctx, "finding cluster")
+
+		cl, err := key.ToCluster(obj)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = r.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: cl.GetName(), Namespace: cl.GetNamespace()}, &cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "found cluster")
+	}
+
+	var invalid error
+	{
+		_, err := r.clusterNetwork.ClusterNetwork(ctx, &cr)
+		if clusternetwork.IsInvalidClusterNetwork(err) {
+			invalid = err
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	updated := cr.DeepCopy()
+	if invalid != nil {
+		conditions.MarkFalse(updated, condition.ClusterNetworkValid, condition.InvalidClusterNetworkReason, apiv1alpha3.ConditionSeverityError, "%s", invalid)
+	} else {
+		conditions.MarkTrue(updated, condition.ClusterNetworkValid)
+	}
+
+	if !key.ConditionsEqual(cr.GetConditions(), updated.GetConditions()) {
+		r.logger.Debugf(ctx, "updating cluster status")
+
+		err := r.k8sClient.CtrlClient().Status().Update(ctx, updated)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated cluster status")
+
+		if invalid != nil {
+			r.event.EmitWarning(ctx, updated, condition.InvalidClusterNetworkReason, invalid.Error())
+		}
+	}
+
+	if invalid != nil {
+		r.logger.Debugf(ctx, "cluster network settings are invalid: %s", invalid)
+		r.logger.Debugf(ctx, "canceling reconciliation")
+		reconciliationcanceledcontext.SetCanceled(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package clusternetworkstatus
+
+import (
+	"context"
+)
+
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	return nil
+}
@@ -0,0 +1,60 @@
+package clusternetworkstatus
+
+import (
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+)
+
+const (
+	Name = "clusternetworkstatus"
+)
+
+type Config struct {
+	ClusterNetwork clusternetwork.Interface
+	Event          recorder.Interface
+	K8sClient      k8sclient.Interface
+	Logger         micrologger.Logger
+}
+
+// Resource validates the per cluster network settings and reflects the
+// result in the ClusterNetworkValid condition of the Cluster CR. Invalid
+// settings cancel the reconciliation so that certificates and cluster values
+// are never generated from them.
+type Resource struct {
+	clusterNetwork clusternetwork.Interface
+	event          recorder.Interface
+	k8sClient      k8sclient.Interface
+	logger         micrologger.Logger
+}
+
+func New(config Config) (*Resource, error) {
+	if config.ClusterNetwork == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ClusterNetwork must not be empty", config)
+	}
+	if config.Event == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Event must not be empty", config)
+	}
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	r := &Resource{
+		clusterNetwork: config.ClusterNetwork,
+		event:          config.Event,
+		k8sClient:      config.K8sClient,
+		logger:         config.Logger,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
@@ -2,8 +2,10 @@ package clusterstatus
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/finalizerskeptcontext"
 	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
 	"k8s.io/apimachinery/pkg/types"
 	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
@@ -31,14 +33,11 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		r.logger.Debugf(ctx, "found cluster")
 	}
 
-	if cr.Status.ControlPlaneInitialized && cr.Status.InfrastructureReady {
-		return nil
-	}
-
 	// Fetching the latest version of the common cluster CR, which is
 	// infrastructure specific, e.g. AWSCluster CR. Once it contains the "Created"
 	// status condition we want to ensure the Cluster CR status and set
-	// InfrastructureReady to true.
+	// InfrastructureReady to true. The phase and failure fields are derived
+	// from it as well.
 	cc := r.newCommonClusterObjectFunc()
 	{
 		r.logger.Debugf(ctx, "finding infrastructure reference")
@@ -51,15 +50,23 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		r.logger.Debugf(ctx, "found infrastructure reference")
 	}
 
-	if !cc.GetCommonClusterStatus().HasCreatedCondition() {
+	err := r.ensureClusterStatus(ctx, cr, clusterStatus(cr, cc.GetCommonClusterStatus()))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) ensureClusterStatus(ctx context.Context, cr apiv1alpha3.Cluster, desired apiv1alpha3.ClusterStatus) error {
+	if reflect.DeepEqual(cr.Status, desired) {
 		return nil
 	}
 
 	{
 		r.logger.Debugf(ctx, "updating cluster status")
 
-		cr.Status.ControlPlaneInitialized = true
-		cr.Status.InfrastructureReady = true
+		cr.Status = desired
 
 		err := r.k8sClient.CtrlClient().Status().Update(ctx, &cr)
 		if err != nil {
@@ -68,6 +75,11 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 		r.logger.Debugf(ctx, "updated cluster status")
 
+		if key.IsDeleted(&cr) {
+			r.logger.Debugf(ctx, "keeping finalizers")
+			finalizerskeptcontext.SetKept(ctx)
+		}
+
 		r.logger.Debugf(ctx, "canceling reconciliation")
 		reconciliationcanceledcontext.SetCanceled(ctx)
 	}
@@ -2,8 +2,48 @@ package clusterstatus
 
 import (
 	"context"
+
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
 )
 
 func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	var cr apiv1alpha3.Cluster
+	{
+		r.logger.Debugf(ctx, "finding cluster")
+
+		cl, err := key.ToCluster(obj)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		err = r.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: cl.GetName(), Namespace: cl.GetNamespace()}, &cr)
+		if apierrors.IsNotFound(err) {
+			r.logger.Debugf(ctx, "did not find cluster")
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "found cluster")
+	}
+
+	// The infrastructure cluster CR might be gone already during deletion,
+	// which is why only the phase and observed generation are maintained here.
+	desired := *cr.Status.DeepCopy()
+	{
+		desired.ObservedGeneration = cr.Generation
+		desired.SetTypedPhase(apiv1alpha3.ClusterPhaseDeleting)
+	}
+
+	err := r.ensureClusterStatus(ctx, cr, desired)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	return nil
 }
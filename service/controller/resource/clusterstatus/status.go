@@ -0,0 +1,56 @@
+package clusterstatus
+
+import (
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+// clusterStatus computes the desired status of the given Cluster CR based on
+// the status of its infrastructure cluster CR, e.g. AWSCluster. The infrastructure
+// flags are only ever flipped to true once the tenant cluster got created.
+func clusterStatus(cr apiv1alpha3.Cluster, status infrastructurev1alpha3.CommonClusterStatus) apiv1alpha3.ClusterStatus {
+	desired := *cr.Status.DeepCopy()
+
+	if status.HasCreatedCondition() {
+		desired.ControlPlaneInitialized = true
+		desired.InfrastructureReady = true
+	}
+
+	desired.FailureReason, desired.FailureMessage = clusterFailure(cr)
+	desired.ObservedGeneration = cr.Generation
+
+	switch {
+	case key.IsDeleted(&cr):
+		desired.SetTypedPhase(apiv1alpha3.ClusterPhaseDeleting)
+	case desired.FailureReason != nil:
+		desired.SetTypedPhase(apiv1alpha3.ClusterPhaseFailed)
+	case desired.InfrastructureReady:
+		desired.SetTypedPhase(apiv1alpha3.ClusterPhaseProvisioned)
+	default:
+		desired.SetTypedPhase(apiv1alpha3.ClusterPhaseProvisioning)
+	}
+
+	return desired
+}
+
+// clusterFailure computes the failure reason and message of the given Cluster
+// CR from the conditions indicating terminal errors, which require user
+// intervention. An invalid cluster network renders the cluster configuration
+// invalid. The Stuck condition is not considered, because it is a timeout
+// heuristic and slow creations and updates may still succeed. Nil is returned
+// for both when there is no terminal error.
+func clusterFailure(cr apiv1alpha3.Cluster) (*capierrors.ClusterStatusError, *string) {
+	if !conditions.IsFalse(&cr, condition.ClusterNetworkValid) {
+		return nil, nil
+	}
+
+	reason := capierrors.InvalidConfigurationClusterError
+	message := conditions.GetMessage(&cr, condition.ClusterNetworkValid)
+
+	return &reason, &message
+}
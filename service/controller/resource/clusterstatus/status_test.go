@@ -0,0 +1,160 @@
+package clusterstatus
+
+import (
+	"strconv"
+	"testing"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+)
+
+func Test_clusterStatus(t *testing.T) {
+	creating := infrastructurev1alpha3.CommonClusterStatus{
+		Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+			{
+				Condition: infrastructurev1alpha3.ClusterStatusConditionCreating,
+			},
+		},
+	}
+	created := infrastructurev1alpha3.CommonClusterStatus{
+		Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+			{
+				Condition: infrastructurev1alpha3.ClusterStatusConditionCreated,
+			},
+		},
+	}
+	updating := infrastructurev1alpha3.CommonClusterStatus{
+		Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+			{
+				Condition: infrastructurev1alpha3.ClusterStatusConditionUpdating,
+			},
+			{
+				Condition: infrastructurev1alpha3.ClusterStatusConditionCreated,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                  string
+		conditions            apiv1alpha3.Conditions
+		deleted               bool
+		status                infrastructurev1alpha3.CommonClusterStatus
+		expectedPhase         apiv1alpha3.ClusterPhase
+		expectedInfraReady    bool
+		expectedFailureReason capierrors.ClusterStatusError
+	}{
+		{
+			name:          "case 0: cluster is creating",
+			status:        creating,
+			expectedPhase: apiv1alpha3.ClusterPhaseProvisioning,
+		},
+		{
+			name:               "case 1: cluster is created",
+			status:             created,
+			expectedPhase:      apiv1alpha3.ClusterPhaseProvisioned,
+			expectedInfraReady: true,
+		},
+		{
+			name: "case 2: cluster creation is stuck",
+			conditions: apiv1alpha3.Conditions{
+				{
+					Type:    condition.Stuck,
+					Status:  corev1.ConditionTrue,
+					Message: "cluster creation exceeded timeout of 30m0s",
+				},
+			},
+			status:        creating,
+			expectedPhase: apiv1alpha3.ClusterPhaseProvisioning,
+		},
+		{
+			name: "case 3: cluster update is stuck",
+			conditions: apiv1alpha3.Conditions{
+				{
+					Type:    condition.Stuck,
+					Status:  corev1.ConditionTrue,
+					Message: "cluster update exceeded timeout of 2h0m0s",
+				},
+			},
+			status:             updating,
+			expectedPhase:      apiv1alpha3.ClusterPhaseProvisioned,
+			expectedInfraReady: true,
+		},
+		{
+			name: "case 4: cluster network is invalid",
+			conditions: apiv1alpha3.Conditions{
+				{
+					Type:    condition.ClusterNetworkValid,
+					Status:  corev1.ConditionFalse,
+					Reason:  condition.InvalidClusterNetworkReason,
+					Message: "invalid cluster IP range",
+				},
+			},
+			status:                creating,
+			expectedPhase:         apiv1alpha3.ClusterPhaseFailed,
+			expectedFailureReason: capierrors.InvalidConfigurationClusterError,
+		},
+		{
+			name: "case 5: cluster is deleted",
+			conditions: apiv1alpha3.Conditions{
+				{
+					Type:   condition.ClusterNetworkValid,
+					Status: corev1.ConditionFalse,
+				},
+			},
+			deleted:               true,
+			status:                creating,
+			expectedPhase:         apiv1alpha3.ClusterPhaseDeleting,
+			expectedFailureReason: capierrors.InvalidConfigurationClusterError,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			cr := apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 3,
+				},
+				Status: apiv1alpha3.ClusterStatus{
+					Conditions: tc.conditions,
+				},
+			}
+			if tc.deleted {
+				now := metav1.Now()
+				cr.DeletionTimestamp = &now
+			}
+
+			status := clusterStatus(cr, tc.status)
+
+			if status.GetTypedPhase() != tc.expectedPhase {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedPhase, status.GetTypedPhase())
+			}
+			if status.InfrastructureReady != tc.expectedInfraReady {
+				t.Fatalf("expected %t to be equal to %t", tc.expectedInfraReady, status.InfrastructureReady)
+			}
+			if status.ControlPlaneInitialized != tc.expectedInfraReady {
+				t.Fatalf("expected %t to be equal to %t", tc.expectedInfraReady, status.ControlPlaneInitialized)
+			}
+			if status.ObservedGeneration != 3 {
+				t.Fatalf("expected %d to be equal to %d", 3, status.ObservedGeneration)
+			}
+
+			if tc.expectedFailureReason == "" {
+				if status.FailureReason != nil || status.FailureMessage != nil {
+					t.Fatalf("expected failure fields to be empty")
+				}
+			} else {
+				if status.FailureReason == nil || *status.FailureReason != tc.expectedFailureReason {
+					t.Fatalf("expected %#q to be equal to %v", tc.expectedFailureReason, status.FailureReason)
+				}
+				if status.FailureMessage == nil {
+					t.Fatalf("expected failure message to be set")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package controlplanestatus
+
+import (
+	"context"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
+)
+
+// ensureControlPlaneReadyCondition reflects the readiness of the master nodes
+// of the tenant cluster in the ControlPlaneReady condition of the Cluster CR.
+// The G8sControlPlane status does not provide conditions, which is why the
+// readiness is put on the Cluster CR.
+func (r *Resource) ensureControlPlaneReadyCondition(ctx context.Context, cr *infrastructurev1alpha3.G8sControlPlane, masterNodes nodecount.Node) error {
+	var cl apiv1alpha3.Cluster
+	{
+		err := r.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: key.ClusterID(cr), Namespace: cr.Namespace}, &cl)
+		if apierrors.IsNotFound(err) {
+			r.logger.Debugf(ctx, "did not find cluster %#q", key.ClusterID(cr))
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	updated := cl.DeepCopy()
+	conditions.Set(updated, controlPlaneReadyCondition(masterNodes))
+
+	if !key.ConditionsEqual(cl.GetConditions(), updated.GetConditions()) {
+		r.logger.Debugf(ctx, "updating %#q condition of cluster %#q", condition.ControlPlaneReady, key.ClusterID(cr))
+
+		err := r.k8sClient.CtrlClient().Status().Update(ctx, updated)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated %#q condition of cluster %#q", condition.ControlPlaneReady, key.ClusterID(cr))
+	}
+
+	return nil
+}
+
+func controlPlaneReadyCondition(masterNodes nodecount.Node) *apiv1alpha3.Condition {
+	if masterNodes.Nodes == 0 || masterNodes.Ready < masterNodes.Nodes {
+		return conditions.FalseCondition(
+			condition.ControlPlaneReady,
+			condition.ControlPlaneNotReadyReason,
+			apiv1alpha3.ConditionSeverityWarning,
+			"%d of %d master nodes ready", masterNodes.Ready, masterNodes.Nodes,
+		)
+	}
+
+	return conditions.TrueCondition(condition.ControlPlaneReady)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
 )
 
@@ -25,17 +26,25 @@ const (
 )
 
 type Config struct {
-	Event     recorder.Interface
-	K8sClient k8sclient.Interface
-	Logger    micrologger.Logger
-	NodeCount nodecount.Interface
+	Event          recorder.Interface
+	K8sClient      k8sclient.Interface
+	Logger         micrologger.Logger
+	NodeCount      nodecount.Interface
+	ReleaseVersion releaseversion.Interface
+
+	NewCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	Provider                   string
 }
 
 type Resource struct {
-	event     recorder.Interface
-	k8sClient k8sclient.Interface
-	logger    micrologger.Logger
-	nodeCount nodecount.Interface
+	event          recorder.Interface
+	k8sClient      k8sclient.Interface
+	logger         micrologger.Logger
+	nodeCount      nodecount.Interface
+	releaseVersion releaseversion.Interface
+
+	newCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	provider                   string
 }
 
 func New(config Config) (*Resource, error) {
@@ -51,12 +60,26 @@ func New(config Config) (*Resource, error) {
 	if config.NodeCount == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.NodeCount must not be empty", config)
 	}
+	if config.ReleaseVersion == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ReleaseVersion must not be empty", config)
+	}
+
+	if config.NewCommonClusterObjectFunc == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.NewCommonClusterObjectFunc must not be empty", config)
+	}
+	if config.Provider == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Provider must not be empty", config)
+	}
 
 	r := &Resource{
-		event:     config.Event,
-		k8sClient: config.K8sClient,
-		logger:    config.Logger,
-		nodeCount: config.NodeCount,
+		event:          config.Event,
+		k8sClient:      config.K8sClient,
+		logger:         config.Logger,
+		nodeCount:      config.NodeCount,
+		releaseVersion: config.ReleaseVersion,
+
+		newCommonClusterObjectFunc: config.NewCommonClusterObjectFunc,
+		provider:                   config.Provider,
 	}
 
 	return r, nil
@@ -101,6 +124,20 @@ func (r *Resource) ensure(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 
+	err = r.ensureControlPlaneReadyCondition(ctx, cr, masterNodes[cr.Labels[label.ControlPlane]])
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// The upgrade progress is not tracked during deletion because the Release CR
+	// the desired provider operator version is taken from might be gone already.
+	if !key.IsDeleted(cr) {
+		err = r.ensureUpgradeProgress(ctx, cr, masterNodes[cr.Labels[label.ControlPlane]])
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
 	{
 		r.logger.Debugf(ctx, "checking if status of control plane needs to be updated")
 
@@ -0,0 +1,107 @@
+package controlplanestatus
+
+import (
+	"context"
+	"fmt"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
+)
+
+// ensureUpgradeProgress reflects the number of master nodes running the
+// desired provider operator version in the upgrade progress annotation of the
+// given G8sControlPlane CR. While the tenant cluster is updating an event is
+// emitted each time the progress changes so that customers can watch rolling
+// upgrades. The G8sControlPlane status does not provide the number of updated
+// replicas, which is why the annotation is used.
+func (r *Resource) ensureUpgradeProgress(ctx context.Context, cr *infrastructurev1alpha3.G8sControlPlane, node nodecount.Node) error {
+	var desiredVersion string
+	{
+		componentVersions, err := r.releaseVersion.ComponentVersion(ctx, cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		providerOperator := fmt.Sprintf("%s-operator", r.provider)
+
+		desiredVersion = componentVersions[providerOperator].Version
+		if desiredVersion == "" {
+			r.logger.Debugf(ctx, "not reporting upgrade progress of control plane")
+			r.logger.Debugf(ctx, "component %#q not found in release", providerOperator)
+			return nil
+		}
+	}
+
+	upgraded := node.Upgraded(desiredVersion)
+	progress := fmt.Sprintf("%d/%d", upgraded, node.Nodes)
+
+	if cr.Annotations[annotation.UpgradeProgress] == progress {
+		return nil
+	}
+
+	{
+		updating, err := r.clusterUpdating(ctx, cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if !updating {
+			return nil
+		}
+	}
+
+	{
+		r.logger.Debugf(ctx, "updating upgrade progress of control plane")
+
+		if cr.Annotations == nil {
+			cr.Annotations = map[string]string{}
+		}
+		cr.Annotations[annotation.UpgradeProgress] = progress
+
+		err := r.k8sClient.CtrlClient().Update(ctx, cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated upgrade progress of control plane")
+		r.event.Emit(ctx, cr, "ControlPlaneUpgradeProgress",
+			fmt.Sprintf("%d of %d master nodes run %s-operator version %s", upgraded, node.Nodes, r.provider, desiredVersion),
+		)
+	}
+
+	return nil
+}
+
+// clusterUpdating returns whether the latest status condition of the provider
+// cluster the given G8sControlPlane CR belongs to is Updating.
+func (r *Resource) clusterUpdating(ctx context.Context, cp *infrastructurev1alpha3.G8sControlPlane) (bool, error) {
+	var cl apiv1alpha3.Cluster
+	{
+		err := r.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: key.ClusterID(cp), Namespace: cp.Namespace}, &cl)
+		if apierrors.IsNotFound(err) {
+			r.logger.Debugf(ctx, "did not find cluster %#q", key.ClusterID(cp))
+			return false, nil
+		} else if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		if cl.Spec.InfrastructureRef == nil {
+			return false, nil
+		}
+	}
+
+	cr := r.newCommonClusterObjectFunc()
+	err := r.k8sClient.CtrlClient().Get(ctx, key.ObjRefToNamespacedName(key.ObjRefFromCluster(cl)), cr)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	return cr.GetCommonClusterStatus().LatestCondition() == infrastructurev1alpha3.ClusterStatusConditionUpdating, nil
+}
@@ -0,0 +1,42 @@
+package deletiondeadline
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// deadlineExceeded returns true when the deletion which started at the given
+// deletion time took longer than the given timeout.
+func deadlineExceeded(deleted time.Time, timeout time.Duration, now time.Time) bool {
+	return now.After(deleted.Add(timeout))
+}
+
+func deadlineExceededMessage(timeout time.Duration, blockers []string) string {
+	return fmt.Sprintf("deletion exceeded the deletion timeout of %s, still waiting for deletion of %s", timeout, strings.Join(blockers, "; "))
+}
+
+func forceDeletedMessage(blockers []string) string {
+	return fmt.Sprintf("released finalizers of force deleted cluster, orphaning %s", strings.Join(blockers, "; "))
+}
+
+// blockersChanged records the given blockers of the deletion of the given
+// tenant cluster and returns true when they differ from the ones recorded
+// before. No blockers reset the recorded ones once the deletion proceeds.
+func (r *Resource) blockersChanged(clusterID string, blockers []string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	b := strings.Join(blockers, "; ")
+	if r.blockers[clusterID] == b {
+		return false
+	}
+
+	if b == "" {
+		delete(r.blockers, clusterID)
+	} else {
+		r.blockers[clusterID] = b
+	}
+
+	return true
+}
@@ -0,0 +1,150 @@
+package deletiondeadline
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_deadlineExceeded(t *testing.T) {
+	deleted := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name             string
+		now              time.Time
+		timeout          time.Duration
+		expectedExceeded bool
+	}{
+		{
+			name:             "case 0: deletion just started",
+			now:              deleted,
+			timeout:          2 * time.Hour,
+			expectedExceeded: false,
+		},
+		{
+			name:             "case 1: deletion within the timeout",
+			now:              deleted.Add(119 * time.Minute),
+			timeout:          2 * time.Hour,
+			expectedExceeded: false,
+		},
+		{
+			name:             "case 2: deletion exactly at the timeout",
+			now:              deleted.Add(2 * time.Hour),
+			timeout:          2 * time.Hour,
+			expectedExceeded: false,
+		},
+		{
+			name:             "case 3: deletion exceeded the timeout",
+			now:              deleted.Add(121 * time.Minute),
+			timeout:          2 * time.Hour,
+			expectedExceeded: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			exceeded := deadlineExceeded(deleted, tc.timeout, tc.now)
+			if exceeded != tc.expectedExceeded {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedExceeded, exceeded)
+			}
+		})
+	}
+}
+
+func Test_deadlineExceededMessage(t *testing.T) {
+	testCases := []struct {
+		name            string
+		timeout         time.Duration
+		blockers        []string
+		expectedMessage string
+	}{
+		{
+			name:            "case 0: single blocker",
+			timeout:         2 * time.Hour,
+			blockers:        []string{"namespace 8y5ck"},
+			expectedMessage: "deletion exceeded the deletion timeout of 2h0m0s, still waiting for deletion of namespace 8y5ck",
+		},
+		{
+			name:            "case 1: multiple blockers",
+			timeout:         30 * time.Minute,
+			blockers:        []string{"MachineDeployments a1b2c, d3e4f", "AWSCluster 8y5ck", "namespace 8y5ck"},
+			expectedMessage: "deletion exceeded the deletion timeout of 30m0s, still waiting for deletion of MachineDeployments a1b2c, d3e4f; AWSCluster 8y5ck; namespace 8y5ck",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			message := deadlineExceededMessage(tc.timeout, tc.blockers)
+			if message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, message)
+			}
+		})
+	}
+}
+
+func Test_blockersChanged(t *testing.T) {
+	r := &Resource{
+		blockers: map[string]string{},
+	}
+
+	testCases := []struct {
+		name            string
+		clusterID       string
+		blockers        []string
+		expectedChanged bool
+	}{
+		{
+			name:            "case 0: first blockers of a cluster",
+			clusterID:       "8y5ck",
+			blockers:        []string{"MachineDeployments a1b2c", "namespace 8y5ck"},
+			expectedChanged: true,
+		},
+		{
+			name:            "case 1: same blockers again",
+			clusterID:       "8y5ck",
+			blockers:        []string{"MachineDeployments a1b2c", "namespace 8y5ck"},
+			expectedChanged: false,
+		},
+		{
+			name:            "case 2: first blockers of another cluster",
+			clusterID:       "al9qy",
+			blockers:        []string{"namespace al9qy"},
+			expectedChanged: true,
+		},
+		{
+			name:            "case 3: fewer blockers",
+			clusterID:       "8y5ck",
+			blockers:        []string{"namespace 8y5ck"},
+			expectedChanged: true,
+		},
+		{
+			name:            "case 4: blockers reset",
+			clusterID:       "8y5ck",
+			blockers:        nil,
+			expectedChanged: true,
+		},
+		{
+			name:            "case 5: blockers reset again",
+			clusterID:       "8y5ck",
+			blockers:        nil,
+			expectedChanged: false,
+		},
+	}
+
+	// The test cases build upon each other and must therefore not run in
+	// parallel.
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			changed := r.blockersChanged(tc.clusterID, tc.blockers)
+			if changed != tc.expectedChanged {
+				t.Fatalf("expected %t to be equal to %t", tc.expectedChanged, changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package deletiondeadline
+
+import (
+	"context"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	cl, err := key.ToCluster(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if !deadlineExceeded(cl.GetDeletionTimestamp().Time, r.deletionTimeout, time.Now()) {
+		r.logger.Debugf(ctx, "deletion deadline not exceeded yet")
+		return nil
+	}
+
+	p, err := r.deletionProgress.Progress(ctx, cl)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	blockers := p.Blockers()
+	if len(blockers) == 0 {
+		r.blockersChanged(key.ClusterID(&cl), nil)
+		r.logger.Debugf(ctx, "deletion deadline exceeded without blockers")
+		return nil
+	}
+
+	// The warning is only emitted when the blockers change, so that stuck
+	// deletions do not emit the same warning on every reconciliation.
+	if r.blockersChanged(key.ClusterID(&cl), blockers) {
+		r.event.EmitWarning(ctx, &cl, "DeletionDeadlineExceeded", deadlineExceededMessage(r.deletionTimeout, blockers))
+	}
+
+	if !key.IsForceDeleted(&cl) {
+		r.logger.Debugf(ctx, "not force deleting cluster")
+		return nil
+	}
+
+	err = r.recordOrphanedResources(ctx, cl, blockers)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.releaseFinalizers(ctx, cl, p)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.event.EmitWarning(ctx, &cl, "ForceDeleted", forceDeletedMessage(blockers))
+	r.blockersChanged(key.ClusterID(&cl), nil)
+
+	// The finalizer of the Cluster CR got released above. Operatorkit must
+	// not process the deleted object any further.
+	r.logger.Debugf(ctx, "canceling reconciliation")
+	reconciliationcanceledcontext.SetCanceled(ctx)
+
+	return nil
+}
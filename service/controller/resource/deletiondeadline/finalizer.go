@@ -0,0 +1,90 @@
+package deletiondeadline
+
+import (
+	"context"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
+)
+
+// releaseFinalizers removes the finalizers of cluster-operator from the
+// MachineDeployment and G8sControlPlane CRs still blocking the deletion of the
+// given Cluster CR and from the Cluster CR itself. Finalizers of other
+// operators are left untouched.
+func (r *Resource) releaseFinalizers(ctx context.Context, cl apiv1alpha3.Cluster, p deletionprogress.Progress) error {
+	for _, name := range p.MachineDeployments {
+		nn := types.NamespacedName{Name: name, Namespace: cl.GetNamespace()}
+		err := r.removeFinalizer(ctx, &apiv1alpha3.MachineDeployment{}, nn, key.Finalizer(key.MachineDeploymentControllerName()))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	for _, name := range p.G8sControlPlanes {
+		nn := types.NamespacedName{Name: name, Namespace: cl.GetNamespace()}
+		err := r.removeFinalizer(ctx, &infrastructurev1alpha3.G8sControlPlane{}, nn, key.Finalizer(key.ControlPlaneControllerName()))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	{
+		nn := types.NamespacedName{Name: cl.GetName(), Namespace: cl.GetNamespace()}
+		err := r.removeFinalizer(ctx, &apiv1alpha3.Cluster{}, nn, key.Finalizer(key.ClusterControllerName()))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// removeFinalizer fetches the object of the given name into obj and removes
+// the given finalizer from it, if present.
+func (r *Resource) removeFinalizer(ctx context.Context, obj runtime.Object, nn types.NamespacedName, finalizer string) error {
+	err := r.k8sClient.CtrlClient().Get(ctx, nn, obj)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var finalizers []string
+	for _, f := range cr.GetFinalizers() {
+		if f != finalizer {
+			finalizers = append(finalizers, f)
+		}
+	}
+
+	if len(finalizers) == len(cr.GetFinalizers()) {
+		return nil
+	}
+
+	r.logger.Debugf(ctx, "removing finalizer %#q from %#q in namespace %#q", finalizer, nn.Name, nn.Namespace)
+
+	cr.SetFinalizers(finalizers)
+
+	err = r.k8sClient.CtrlClient().Update(ctx, obj)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "removed finalizer %#q from %#q in namespace %#q", finalizer, nn.Name, nn.Namespace)
+
+	return nil
+}
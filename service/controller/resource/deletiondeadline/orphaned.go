@@ -0,0 +1,94 @@
+package deletiondeadline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+const (
+	orphanedDeletedKey   = "deleted"
+	orphanedResourcesKey = "resources"
+)
+
+// recordOrphanedResources records the given blockers in the orphaned resources
+// config map of the tenant cluster before the finalizers get released. The
+// config map lives in the namespace of the Cluster CR and therefore outlives
+// the tenant cluster.
+func (r *Resource) recordOrphanedResources(ctx context.Context, cl apiv1alpha3.Cluster, blockers []string) error {
+	name := key.OrphanedResourcesConfigMapName(&cl)
+	namespace := cl.GetNamespace()
+
+	var data map[string]string
+	{
+		b, err := yaml.Marshal(blockers)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		data = map[string]string{
+			orphanedDeletedKey:   cl.GetDeletionTimestamp().UTC().Format(time.RFC3339),
+			orphanedResourcesKey: string(b),
+		}
+	}
+
+	r.logger.Debugf(ctx, "finding orphaned resources config map %#q in namespace %#q", name, namespace)
+
+	cm, err := r.k8sClient.K8sClient().CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		r.logger.Debugf(ctx, "did not find orphaned resources config map %#q in namespace %#q", name, namespace)
+
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Annotations: map[string]string{
+					annotation.Notes: fmt.Sprintf("DO NOT EDIT. Values managed by %s.", project.Name()),
+				},
+				Labels: map[string]string{
+					label.Cluster:      key.ClusterID(&cl),
+					label.ManagedBy:    project.Name(),
+					label.Organization: key.OrganizationID(&cl),
+				},
+			},
+			Data: data,
+		}
+
+		r.logger.Debugf(ctx, "creating orphaned resources config map %#q in namespace %#q", name, namespace)
+
+		_, err = r.k8sClient.K8sClient().CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "created orphaned resources config map %#q in namespace %#q", name, namespace)
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else {
+		r.logger.Debugf(ctx, "found orphaned resources config map %#q in namespace %#q", name, namespace)
+		r.logger.Debugf(ctx, "updating orphaned resources config map %#q in namespace %#q", name, namespace)
+
+		cm.Data = data
+
+		_, err = r.k8sClient.K8sClient().CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated orphaned resources config map %#q in namespace %#q", name, namespace)
+	}
+
+	return nil
+}
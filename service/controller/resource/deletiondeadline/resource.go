@@ -0,0 +1,83 @@
+package deletiondeadline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+)
+
+const (
+	Name = "deletiondeadline"
+)
+
+type Config struct {
+	DeletionProgress deletionprogress.Interface
+	Event            recorder.Interface
+	K8sClient        k8sclient.Interface
+	Logger           micrologger.Logger
+
+	// DeletionTimeout is the duration after which the deletion of a tenant
+	// cluster is considered stuck.
+	DeletionTimeout time.Duration
+}
+
+// Resource watches over the deletion of tenant clusters. Once the deletion of
+// a Cluster CR exceeded the deletion timeout while objects of the tenant
+// cluster still block it, a warning event listing the blockers is emitted. In
+// case the Cluster CR carries the force delete annotation, the blockers are
+// recorded as orphaned and the finalizers of cluster-operator are released
+// from the remaining MachineDeployment and G8sControlPlane CRs as well as from
+// the Cluster CR itself.
+type Resource struct {
+	deletionProgress deletionprogress.Interface
+	event            recorder.Interface
+	k8sClient        k8sclient.Interface
+	logger           micrologger.Logger
+
+	deletionTimeout time.Duration
+
+	mutex    sync.Mutex
+	blockers map[string]string
+}
+
+func New(config Config) (*Resource, error) {
+	if config.DeletionProgress == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.DeletionProgress must not be empty", config)
+	}
+	if config.Event == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Event must not be empty", config)
+	}
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if config.DeletionTimeout <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.DeletionTimeout must be greater than zero", config)
+	}
+
+	r := &Resource{
+		deletionProgress: config.DeletionProgress,
+		event:            config.Event,
+		k8sClient:        config.K8sClient,
+		logger:           config.Logger,
+
+		deletionTimeout: config.DeletionTimeout,
+
+		blockers: map[string]string{},
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
@@ -0,0 +1,80 @@
+package deletionprotection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
+	corev1 "k8s.io/api/core/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+// ensureCondition updates the DeletionProtected condition of the given
+// Cluster CR. The reconciliation is canceled after updating the status, since
+// following resources would otherwise work with an outdated object.
+func (r *Resource) ensureCondition(ctx context.Context, cl apiv1alpha3.Cluster) (bool, error) {
+	updated := cl.DeepCopy()
+	{
+		c := deletionProtectedCondition(cl)
+		if c != nil {
+			conditions.Set(updated, c)
+		} else {
+			conditions.Delete(updated, condition.DeletionProtected)
+		}
+	}
+
+	if key.ConditionsEqual(cl.GetConditions(), updated.GetConditions()) {
+		return false, nil
+	}
+
+	r.logger.Debugf(ctx, "updating %#q condition", condition.DeletionProtected)
+
+	err := r.k8sClient.CtrlClient().Status().Update(ctx, updated)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "updated %#q condition", condition.DeletionProtected)
+
+	r.logger.Debugf(ctx, "canceling reconciliation")
+	reconciliationcanceledcontext.SetCanceled(ctx)
+
+	return true, nil
+}
+
+// deletionProtectedCondition computes the DeletionProtected condition of the
+// given Cluster CR. Nil is returned for Cluster CRs which are not protected,
+// in which case the condition is removed.
+func deletionProtectedCondition(cl apiv1alpha3.Cluster) *apiv1alpha3.Condition {
+	if !key.IsDeletionProtected(&cl) {
+		return nil
+	}
+
+	if key.IsDeleted(&cl) {
+		return &apiv1alpha3.Condition{
+			Type:    condition.DeletionProtected,
+			Status:  corev1.ConditionTrue,
+			Reason:  condition.DeletionBlockedReason,
+			Message: deletionBlockedMessage(cl),
+		}
+	}
+
+	return conditions.TrueCondition(condition.DeletionProtected)
+}
+
+func deletionBlockedMessage(cl apiv1alpha3.Cluster) string {
+	msg := fmt.Sprintf("deletion is blocked until annotation %#q is removed", annotation.DeletionProtection)
+
+	reason := cl.GetAnnotations()[annotation.DeletionProtection]
+	if reason != "" {
+		msg = fmt.Sprintf("%s, cluster is protected: %s", msg, reason)
+	}
+
+	return msg
+}
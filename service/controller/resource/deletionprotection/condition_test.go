@@ -0,0 +1,109 @@
+package deletionprotection
+
+import (
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+)
+
+func Test_deletionProtectedCondition(t *testing.T) {
+	deleted := metav1.Now()
+
+	testCases := []struct {
+		name            string
+		cluster         apiv1alpha3.Cluster
+		expectedNil     bool
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name: "case 0: unprotected cluster has no condition",
+			cluster: apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "8y5ck",
+				},
+			},
+			expectedNil: true,
+		},
+		{
+			name: "case 1: protected cluster",
+			cluster: apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "8y5ck",
+					Annotations: map[string]string{
+						annotation.DeletionProtection: "",
+					},
+				},
+			},
+		},
+		{
+			name: "case 2: deleted protected cluster",
+			cluster: apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "8y5ck",
+					Annotations: map[string]string{
+						annotation.DeletionProtection: "",
+					},
+					DeletionTimestamp: &deleted,
+				},
+			},
+			expectedReason:  condition.DeletionBlockedReason,
+			expectedMessage: "deletion is blocked until annotation `cluster-operator.giantswarm.io/deletion-protection` is removed",
+		},
+		{
+			name: "case 3: deleted protected cluster with reason",
+			cluster: apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "8y5ck",
+					Annotations: map[string]string{
+						annotation.DeletionProtection: "production workloads",
+					},
+					DeletionTimestamp: &deleted,
+				},
+			},
+			expectedReason:  condition.DeletionBlockedReason,
+			expectedMessage: "deletion is blocked until annotation `cluster-operator.giantswarm.io/deletion-protection` is removed, cluster is protected: production workloads",
+		},
+		{
+			name: "case 4: deleted unprotected cluster has no condition",
+			cluster: apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "8y5ck",
+					DeletionTimestamp: &deleted,
+				},
+			},
+			expectedNil: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c := deletionProtectedCondition(tc.cluster)
+
+			if tc.expectedNil {
+				if c != nil {
+					t.Fatalf("expected condition to be nil, got %#v", c)
+				}
+				return
+			}
+
+			if c.Status != corev1.ConditionTrue {
+				t.Fatalf("expected %#q to be equal to %#q", corev1.ConditionTrue, c.Status)
+			}
+			if c.Reason != tc.expectedReason {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedReason, c.Reason)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
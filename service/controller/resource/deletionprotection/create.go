@@ -0,0 +1,23 @@
+package deletionprotection
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cl, err := key.ToCluster(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	_, err = r.ensureCondition(ctx, cl)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
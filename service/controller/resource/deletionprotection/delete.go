@@ -0,0 +1,43 @@
+package deletionprotection
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/finalizerskeptcontext"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	cl, err := key.ToCluster(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if !key.IsDeletionProtected(&cl) {
+		return nil
+	}
+
+	updated, err := r.ensureCondition(ctx, cl)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// The warning is only emitted once per blocked deletion, when the
+	// DeletionProtected condition changes to its blocked reason.
+	if updated {
+		r.event.EmitWarning(ctx, &cl, "DeletionBlocked", deletionBlockedMessage(cl))
+	}
+
+	r.logger.Debugf(ctx, "cluster is protected from deletion")
+
+	r.logger.Debugf(ctx, "keeping finalizers")
+	finalizerskeptcontext.SetKept(ctx)
+
+	r.logger.Debugf(ctx, "canceling reconciliation")
+	reconciliationcanceledcontext.SetCanceled(ctx)
+
+	return nil
+}
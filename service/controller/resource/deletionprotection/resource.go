@@ -0,0 +1,54 @@
+package deletionprotection
+
+import (
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+)
+
+const (
+	Name = "deletionprotection"
+)
+
+type Config struct {
+	Event     recorder.Interface
+	K8sClient k8sclient.Interface
+	Logger    micrologger.Logger
+}
+
+// Resource honours the deletion protection annotation of Cluster CRs. It
+// maintains the DeletionProtected condition and, once a protected Cluster CR
+// got deleted, keeps its finalizers and cancels the reconciliation, so that
+// none of the following resources start tearing down the tenant cluster. It
+// must therefore be the first resource of the cluster controller.
+type Resource struct {
+	event     recorder.Interface
+	k8sClient k8sclient.Interface
+	logger    micrologger.Logger
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Event == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Event must not be empty", config)
+	}
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	r := &Resource{
+		event:     config.Event,
+		k8sClient: config.K8sClient,
+		logger:    config.Logger,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
@@ -0,0 +1,127 @@
+package drainnodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/giantswarm/errors/tenant"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/finalizerskeptcontext"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+)
+
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	md, err := key.ToMachineDeployment(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if _, ok := md.Annotations[annotation.DrainCompleted]; ok {
+		r.logger.Debugf(ctx, "nodes of node pool %#q are drained", key.MachineDeployment(&md))
+		return nil
+	}
+
+	started, _ := time.Parse(time.RFC3339, md.Annotations[annotation.DrainStarted])
+	if !started.IsZero() && time.Since(started) > r.drainTimeout {
+		r.event.EmitWarning(ctx, &md, "DrainTimedOut", fmt.Sprintf("draining nodes of node pool %s did not finish within %s, deleting node pool anyway", key.MachineDeployment(&md), r.drainTimeout))
+		return r.ensureDrainCompleted(ctx, md)
+	}
+
+	k8sClient, err := r.tenantClient.K8sClient(ctx, &md)
+	if tenantclient.IsNotAvailable(err) {
+		r.event.EmitWarning(ctx, &md, "DrainSkipped", fmt.Sprintf("tenant API is not available, deleting node pool %s without draining its nodes", key.MachineDeployment(&md)))
+		return r.ensureDrainCompleted(ctx, md)
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "finding nodes of node pool %#q", key.MachineDeployment(&md))
+
+	o := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{label.MachineDeployment: key.MachineDeployment(&md)}).String(),
+	}
+	list, err := k8sClient.K8sClient().CoreV1().Nodes().List(ctx, o)
+	if tenant.IsAPINotAvailable(err) {
+		r.event.EmitWarning(ctx, &md, "DrainSkipped", fmt.Sprintf("tenant API is not available, deleting node pool %s without draining its nodes", key.MachineDeployment(&md)))
+		return r.ensureDrainCompleted(ctx, md)
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "found %d nodes of node pool %#q", len(list.Items), key.MachineDeployment(&md))
+
+	if len(list.Items) == 0 {
+		return nil
+	}
+
+	if started.IsZero() {
+		err = r.patchAnnotation(ctx, md, annotation.DrainStarted)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.event.Emit(ctx, &md, "DrainStarted", fmt.Sprintf("draining %d nodes of node pool %s", len(list.Items), key.MachineDeployment(&md)))
+	}
+
+	p, err := r.drain(ctx, k8sClient.K8sClient(), list.Items)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if p.Done() {
+		r.event.Emit(ctx, &md, "DrainCompleted", fmt.Sprintf("drained %d nodes of node pool %s", p.Nodes, key.MachineDeployment(&md)))
+		return r.ensureDrainCompleted(ctx, md)
+	}
+
+	// The event is only emitted when the drain progress changes, so that
+	// drains blocked e.g. by PodDisruptionBudgets do not emit the same event
+	// on every reconciliation.
+	if r.progressChanged(key.MachineDeployment(&md), p) {
+		r.event.Emit(ctx, &md, "DrainInProgress", fmt.Sprintf("node pool %s: %s", key.MachineDeployment(&md), p))
+	}
+
+	r.logger.Debugf(ctx, "keeping finalizers")
+	finalizerskeptcontext.SetKept(ctx)
+
+	r.logger.Debugf(ctx, "canceling reconciliation")
+	reconciliationcanceledcontext.SetCanceled(ctx)
+
+	return nil
+}
+
+func (r *Resource) ensureDrainCompleted(ctx context.Context, md apiv1alpha3.MachineDeployment) error {
+	err := r.patchAnnotation(ctx, md, annotation.DrainCompleted)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.resetProgress(key.MachineDeployment(&md))
+
+	return nil
+}
+
+func (r *Resource) patchAnnotation(ctx context.Context, md apiv1alpha3.MachineDeployment, name string) error {
+	r.logger.Debugf(ctx, "setting annotation %#q", name)
+
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, name, time.Now().UTC().Format(time.RFC3339)))
+
+	err := r.k8sClient.CtrlClient().Patch(ctx, &md, client.RawPatch(types.MergePatchType, patch))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "set annotation %#q", name)
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package drainnodes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// mirrorPodAnnotation is the annotation of static pods mirrored into the
+	// Kubernetes API by the kubelet. They cannot be evicted.
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+type drainProgress struct {
+	// Blocked is the number of evictions rejected because of
+	// PodDisruptionBudgets.
+	Blocked int
+	// DrainedNodes is the number of nodes without pods left to evict.
+	DrainedNodes int
+	// Nodes is the number of nodes of the node pool.
+	Nodes int
+	// Pods is the number of pods left on the nodes of the node pool.
+	Pods int
+}
+
+func (p drainProgress) Done() bool {
+	return p.DrainedNodes == p.Nodes
+}
+
+func (p drainProgress) String() string {
+	return fmt.Sprintf("drained %d of %d nodes, %d pods remaining, %d evictions blocked by PodDisruptionBudgets", p.DrainedNodes, p.Nodes, p.Pods, p.Blocked)
+}
+
+// progressChanged records the given drain progress of the given node pool and
+// returns true when it differs from the one recorded before.
+func (r *Resource) progressChanged(id string, p drainProgress) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if recorded, ok := r.progress[id]; ok && recorded == p {
+		return false
+	}
+
+	r.progress[id] = p
+
+	return true
+}
+
+// resetProgress drops the recorded drain progress of the given node pool once
+// its drain is completed.
+func (r *Resource) resetProgress(id string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.progress, id)
+}
+
+// drain cordons the given nodes and evicts their pods once. It does not wait
+// for evicted pods to terminate, so that it has to be called again until the
+// returned progress is done.
+func (r *Resource) drain(ctx context.Context, k8sClient kubernetes.Interface, nodes []corev1.Node) (drainProgress, error) {
+	p := drainProgress{
+		Nodes: len(nodes),
+	}
+
+	for _, n := range nodes {
+		if !n.Spec.Unschedulable {
+			r.logger.Debugf(ctx, "cordoning node %#q", n.Name)
+
+			patch := []byte(`{"spec":{"unschedulable":true}}`)
+			_, err := k8sClient.CoreV1().Nodes().Patch(ctx, n.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+			if apierrors.IsNotFound(err) {
+				p.DrainedNodes++
+				continue
+			} else if err != nil {
+				return drainProgress{}, microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "cordoned node %#q", n.Name)
+		}
+
+		var pods []corev1.Pod
+		{
+			o := metav1.ListOptions{
+				FieldSelector: fields.OneTermEqualSelector("spec.nodeName", n.Name).String(),
+			}
+			list, err := k8sClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, o)
+			if err != nil {
+				return drainProgress{}, microerror.Mask(err)
+			}
+			pods = podsToEvict(list.Items)
+		}
+
+		if len(pods) == 0 {
+			p.DrainedNodes++
+			continue
+		}
+
+		p.Pods += len(pods)
+
+		for _, pod := range pods {
+			// Pods being deleted already are waited for.
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			e := &policyv1beta1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pod.Name,
+					Namespace: pod.Namespace,
+				},
+			}
+
+			err := k8sClient.CoreV1().Pods(pod.Namespace).Evict(ctx, e)
+			if apierrors.IsNotFound(err) {
+				continue
+			} else if apierrors.IsTooManyRequests(err) {
+				// The eviction would violate a PodDisruptionBudget, so it is
+				// retried with the next reconciliation.
+				p.Blocked++
+				continue
+			} else if err != nil {
+				return drainProgress{}, microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "evicted pod %#q from node %#q", fmt.Sprintf("%s/%s", pod.Namespace, pod.Name), n.Name)
+		}
+	}
+
+	return p, nil
+}
+
+// podsToEvict returns the pods which have to be evicted for draining a node.
+// Pods of DaemonSets are ignored, because they tolerate cordoned nodes and
+// would be scheduled again. Mirror pods cannot be evicted and terminated pods
+// do not need to be.
+func podsToEvict(pods []corev1.Pod) []corev1.Pod {
+	var evict []corev1.Pod
+	for _, p := range pods {
+		if _, ok := p.Annotations[mirrorPodAnnotation]; ok {
+			continue
+		}
+		if p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if isDaemonSetPod(p) {
+			continue
+		}
+
+		evict = append(evict, p)
+	}
+
+	return evict
+}
+
+func isDaemonSetPod(p corev1.Pod) bool {
+	for _, o := range p.OwnerReferences {
+		if o.Kind == "DaemonSet" {
+			return true
+		}
+	}
+
+	return false
+}
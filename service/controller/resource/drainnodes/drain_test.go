@@ -0,0 +1,201 @@
+package drainnodes
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_podsToEvict(t *testing.T) {
+	testCases := []struct {
+		name         string
+		pods         []corev1.Pod
+		expectedPods []string
+	}{
+		{
+			name:         "case 0: no pods",
+			expectedPods: nil,
+		},
+		{
+			name: "case 1: running pods are evicted",
+			pods: []corev1.Pod{
+				newPod("api-0", corev1.PodRunning),
+				newPod("api-1", corev1.PodPending),
+			},
+			expectedPods: []string{
+				"api-0",
+				"api-1",
+			},
+		},
+		{
+			name: "case 2: daemon set, mirror and terminated pods are ignored",
+			pods: []corev1.Pod{
+				newPod("api-0", corev1.PodRunning),
+				func() corev1.Pod {
+					p := newPod("calico-node-7x2lp", corev1.PodRunning)
+					p.OwnerReferences = []metav1.OwnerReference{
+						{
+							Kind: "DaemonSet",
+							Name: "calico-node",
+						},
+					}
+					return p
+				}(),
+				func() corev1.Pod {
+					p := newPod("k8s-api-healthz-ip-10-1-0-1", corev1.PodRunning)
+					p.Annotations = map[string]string{
+						mirrorPodAnnotation: "8d6c4a9f",
+					}
+					return p
+				}(),
+				newPod("migration-x7d2k", corev1.PodSucceeded),
+				newPod("backup-q9s8v", corev1.PodFailed),
+			},
+			expectedPods: []string{
+				"api-0",
+			},
+		},
+		{
+			name: "case 3: pods owned by replica sets are evicted",
+			pods: []corev1.Pod{
+				func() corev1.Pod {
+					p := newPod("web-5d8f7-kq2xz", corev1.PodRunning)
+					p.OwnerReferences = []metav1.OwnerReference{
+						{
+							Kind: "ReplicaSet",
+							Name: "web-5d8f7",
+						},
+					}
+					return p
+				}(),
+			},
+			expectedPods: []string{
+				"web-5d8f7-kq2xz",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var names []string
+			for _, p := range podsToEvict(tc.pods) {
+				names = append(names, p.Name)
+			}
+
+			if !reflect.DeepEqual(names, tc.expectedPods) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedPods, names)
+			}
+		})
+	}
+}
+
+func Test_drainProgress(t *testing.T) {
+	testCases := []struct {
+		name            string
+		progress        drainProgress
+		expectedDone    bool
+		expectedMessage string
+	}{
+		{
+			name: "case 0: all nodes drained",
+			progress: drainProgress{
+				DrainedNodes: 3,
+				Nodes:        3,
+			},
+			expectedDone:    true,
+			expectedMessage: "drained 3 of 3 nodes, 0 pods remaining, 0 evictions blocked by PodDisruptionBudgets",
+		},
+		{
+			name: "case 1: evictions blocked by pod disruption budgets",
+			progress: drainProgress{
+				Blocked:      2,
+				DrainedNodes: 1,
+				Nodes:        3,
+				Pods:         7,
+			},
+			expectedDone:    false,
+			expectedMessage: "drained 1 of 3 nodes, 7 pods remaining, 2 evictions blocked by PodDisruptionBudgets",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			if tc.progress.Done() != tc.expectedDone {
+				t.Fatalf("expected %t to be equal to %t", tc.expectedDone, tc.progress.Done())
+			}
+			if tc.progress.String() != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, tc.progress.String())
+			}
+		})
+	}
+}
+
+func newPod(name string, phase corev1.PodPhase) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func Test_progressChanged(t *testing.T) {
+	r := &Resource{
+		progress: map[string]drainProgress{},
+	}
+
+	testCases := []struct {
+		name            string
+		id              string
+		progress        drainProgress
+		expectedChanged bool
+	}{
+		{
+			name:            "case 0: first progress of a node pool",
+			id:              "a1b2c",
+			progress:        drainProgress{Blocked: 1, DrainedNodes: 1, Nodes: 3, Pods: 5},
+			expectedChanged: true,
+		},
+		{
+			name:            "case 1: same progress again",
+			id:              "a1b2c",
+			progress:        drainProgress{Blocked: 1, DrainedNodes: 1, Nodes: 3, Pods: 5},
+			expectedChanged: false,
+		},
+		{
+			name:            "case 2: first progress of another node pool",
+			id:              "d3e4f",
+			progress:        drainProgress{DrainedNodes: 0, Nodes: 2, Pods: 4},
+			expectedChanged: true,
+		},
+		{
+			name:            "case 3: more nodes drained",
+			id:              "a1b2c",
+			progress:        drainProgress{Blocked: 1, DrainedNodes: 2, Nodes: 3, Pods: 2},
+			expectedChanged: true,
+		},
+	}
+
+	// The test cases build upon each other and must therefore not run in
+	// parallel.
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			changed := r.progressChanged(tc.id, tc.progress)
+			if changed != tc.expectedChanged {
+				t.Fatalf("expected %t to be equal to %t", tc.expectedChanged, changed)
+			}
+		})
+	}
+}
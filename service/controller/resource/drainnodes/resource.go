@@ -0,0 +1,81 @@
+package drainnodes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+)
+
+const (
+	Name = "drainnodes"
+)
+
+type Config struct {
+	Event        recorder.Interface
+	K8sClient    k8sclient.Interface
+	Logger       micrologger.Logger
+	TenantClient tenantclient.Interface
+
+	// DrainTimeout is the duration after which draining the nodes of a deleted
+	// node pool is given up on and its deletion proceeds.
+	DrainTimeout time.Duration
+}
+
+// Resource cordons and drains the tenant nodes of deleted MachineDeployment
+// CRs before their infrastructure reference is deleted. Pods are evicted
+// using the eviction API, so that PodDisruptionBudgets are respected. The
+// finalizers of the MachineDeployment CR are kept until all nodes are drained
+// or the drain timeout is exceeded.
+type Resource struct {
+	event        recorder.Interface
+	k8sClient    k8sclient.Interface
+	logger       micrologger.Logger
+	tenantClient tenantclient.Interface
+
+	drainTimeout time.Duration
+
+	mutex    sync.Mutex
+	progress map[string]drainProgress
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Event == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Event must not be empty", config)
+	}
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.TenantClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TenantClient must not be empty", config)
+	}
+
+	if config.DrainTimeout <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.DrainTimeout must be greater than zero", config)
+	}
+
+	r := &Resource{
+		event:        config.Event,
+		k8sClient:    config.K8sClient,
+		logger:       config.Logger,
+		tenantClient: config.TenantClient,
+
+		drainTimeout: config.DrainTimeout,
+
+		progress: map[string]drainProgress{},
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
@@ -0,0 +1,44 @@
+package evicttenantclient
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+)
+
+const (
+	Name = "evicttenantclient"
+)
+
+type Config struct {
+	Logger       micrologger.Logger
+	TenantClient tenantclient.Interface
+}
+
+// Resource evicts the pooled tenant client of a tenant cluster once its
+// Cluster CR is deleted.
+type Resource struct {
+	logger       micrologger.Logger
+	tenantClient tenantclient.Interface
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.TenantClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TenantClient must not be empty", config)
+	}
+
+	r := &Resource{
+		logger:       config.Logger,
+		tenantClient: config.TenantClient,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
@@ -0,0 +1,36 @@
+package kubeconfig
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/giantswarm/microerror"
+)
+
+type certInfo struct {
+	Expiry time.Time
+	Serial string
+}
+
+// newCertInfo parses the PEM encoded client certificate embedded into the
+// kubeconfig and returns the information used to link the kubeconfig secret to
+// the certificate it was derived from.
+func newCertInfo(crt []byte) (certInfo, error) {
+	b, _ := pem.Decode(crt)
+	if b == nil {
+		return certInfo{}, microerror.Maskf(invalidCertError, "client certificate must be PEM encoded")
+	}
+
+	c, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return certInfo{}, microerror.Maskf(invalidCertError, err.Error())
+	}
+
+	i := certInfo{
+		Expiry: c.NotAfter.UTC(),
+		Serial: c.SerialNumber.Text(16),
+	}
+
+	return i, nil
+}
@@ -0,0 +1,87 @@
+package kubeconfig
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func Test_newCertInfo(t *testing.T) {
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		crt          []byte
+		expectedInfo certInfo
+		errorMatcher func(error) bool
+	}{
+		{
+			name: "case 0: valid certificate",
+			crt:  newTestCert(t, big.NewInt(0xabc123), expiry),
+			expectedInfo: certInfo{
+				Expiry: expiry,
+				Serial: "abc123",
+			},
+		},
+		{
+			name:         "case 1: not PEM encoded",
+			crt:          []byte("not a certificate"),
+			errorMatcher: IsInvalidCert,
+		},
+		{
+			name:         "case 2: PEM encoded garbage",
+			crt:          pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")}),
+			errorMatcher: IsInvalidCert,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info, err := newCertInfo(tc.crt)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !info.Expiry.Equal(tc.expectedInfo.Expiry) {
+				t.Fatalf("expiry == %s, want %s", info.Expiry, tc.expectedInfo.Expiry)
+			}
+			if info.Serial != tc.expectedInfo.Serial {
+				t.Fatalf("serial == %#q, want %#q", info.Serial, tc.expectedInfo.Serial)
+			}
+		})
+	}
+}
+
+func newTestCert(t *testing.T, serial *big.Int, expiry time.Time) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "app-operator"},
+		NotBefore:    expiry.Add(-time.Hour),
+		NotAfter:     expiry,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -1,18 +1,30 @@
 package kubeconfig
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/giantswarm/kubeconfig/v4"
 	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/resourcecanceledcontext"
 	"github.com/giantswarm/tenantcluster/v4/pkg/tenantcluster"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
 	"github.com/giantswarm/cluster-operator/v3/pkg/label"
 	"github.com/giantswarm/cluster-operator/v3/pkg/project"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
 )
 
 func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) ([]*corev1.Secret, error) {
@@ -20,13 +32,13 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) ([]*cor
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
-	bd, err := r.baseDomain.BaseDomain(ctx, &cr)
+	endpoint, err := r.apiEndpoint.APIEndpoint(ctx, &cr)
 	if err != nil {
 		return nil, microerror.Mask(err)
 	}
 	var restConfig *rest.Config
 	{
-		restConfig, err = r.tenant.NewRestConfig(ctx, key.ClusterID(&cr), key.KubeConfigEndpoint(&cr, bd))
+		restConfig, err = r.tenant.NewRestConfig(ctx, key.ClusterID(&cr), key.KubeConfigEndpoint(endpoint))
 		if tenantcluster.IsTimeout(err) {
 			r.logger.Debugf(ctx, "timeout fetching certificates")
 			r.logger.Debugf(ctx, "canceling resource")
@@ -37,17 +49,77 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) ([]*cor
 		}
 	}
 
-	var secret *corev1.Secret
+	var b []byte
 	{
-		b, err := kubeconfig.NewKubeConfigForRESTConfig(ctx, restConfig, key.KubeConfigClusterName(&cr), "")
+		b, err = kubeconfig.NewKubeConfigForRESTConfig(ctx, restConfig, key.KubeConfigClusterName(&cr), "")
 		if err != nil {
 			return nil, microerror.Mask(err)
 		}
+	}
+
+	var ci certInfo
+	{
+		ci, err = newCertInfo(restConfig.TLSClientConfig.CertData)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var current *corev1.Secret
+	{
+		current, err = r.k8sClient.CoreV1().Secrets(key.ClusterID(&cr)).Get(ctx, key.KubeConfigSecretName(&cr), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			current = nil
+		} else if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
 
+	// The serial of the certificate the current kubeconfig was derived from is
+	// tracked so that a reissued certificate is always propagated to the
+	// kubeconfig secret, even if nothing else changed. The rotation is only
+	// announced once the updated secret got published, see ApplyUpdateChange.
+	if current != nil {
+		s := current.Annotations[annotation.KubeConfigCertSerial]
+		if s != "" && s != ci.Serial {
+			r.logger.Debugf(ctx, "certificate of kubeconfig for tenant cluster %#q rotated from serial %#q to %#q", key.ClusterID(&cr), s, ci.Serial)
+			r.recordRotation(cr, ci)
+		}
+	}
+
+	// The generated kubeconfig is only published once it has been proven to
+	// work against the tenant API. Otherwise consumers like app-operator would
+	// pick up credentials which cannot be used.
+	var serverVersion string
+	{
+		r.logger.Debugf(ctx, "checking tenant API with kubeconfig for tenant cluster %#q", key.ClusterID(&cr))
+
+		serverVersion, err = r.checkTenantAPI(ctx, cr, b)
+		if err != nil {
+			r.logger.Debugf(ctx, "tenant API check failed for tenant cluster %#q: %s", key.ClusterID(&cr), err)
+			r.emitCheckFailure(ctx, cr, current, err)
+			r.logger.Debugf(ctx, "canceling resource")
+			resourcecanceledcontext.SetCanceled(ctx)
+			return nil, nil
+		}
+
+		r.resetCheckFailure(cr)
+
+		r.logger.Debugf(ctx, "checked tenant API with kubeconfig for tenant cluster %#q", key.ClusterID(&cr))
+	}
+
+	var secret *corev1.Secret
+	{
 		secret = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      key.KubeConfigSecretName(&cr),
 				Namespace: key.ClusterID(&cr),
+				Annotations: map[string]string{
+					annotation.KubeConfigCertExpiry:    ci.Expiry.Format(time.RFC3339),
+					annotation.KubeConfigCertSerial:    ci.Serial,
+					annotation.KubeConfigCheckTime:     checkTime(current, b, serverVersion),
+					annotation.KubeConfigServerVersion: serverVersion,
+				},
 				Labels: map[string]string{
 					label.Cluster:      key.ClusterID(&cr),
 					label.ManagedBy:    project.Name(),
@@ -63,3 +135,104 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) ([]*cor
 
 	return []*corev1.Secret{secret}, nil
 }
+
+// checkTenantAPI requests the server version of the tenant API using a REST
+// config built from the given generated kubeconfig and thereby verifies that
+// the endpoint answers and that the app-operator API credentials published
+// with the kubeconfig authenticate. Tenant APIs whose circuit breaker is open
+// are not checked at all, so that reconciliations do not wait for timeouts.
+func (r *Resource) checkTenantAPI(ctx context.Context, cr apiv1alpha3.Cluster, kubeConfig []byte) (string, error) {
+	{
+		s, err := r.tenantClient.Breaker(ctx, &cr)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		if s.State == tenantclient.BreakerOpen {
+			return "", microerror.Maskf(notAvailableError, "circuit breaker of tenant cluster %#q is open", key.ClusterID(&cr))
+		}
+	}
+
+	var k8sClient kubernetes.Interface
+	{
+		restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeConfig)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		k8sClient, err = kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	b, err := k8sClient.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Raw()
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	var v version.Info
+	err = json.Unmarshal(b, &v)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return v.GitVersion, nil
+}
+
+// emitCheckFailure emits a warning event in case the currently published
+// kubeconfig was verified before, which means a previously working kubeconfig
+// started failing. The event is only emitted once per failure period, which
+// ends with the next successful check.
+func (r *Resource) emitCheckFailure(ctx context.Context, cr apiv1alpha3.Cluster, current *corev1.Secret, err error) {
+	if current == nil {
+		return
+	}
+	if current.Annotations[annotation.KubeConfigServerVersion] == "" {
+		return
+	}
+
+	r.mutex.Lock()
+	failing := r.failing[key.ClusterID(&cr)]
+	r.failing[key.ClusterID(&cr)] = true
+	r.mutex.Unlock()
+
+	if failing {
+		return
+	}
+
+	r.event.EmitWarning(ctx, &cr, "KubeConfigCheckFailed",
+		fmt.Sprintf("kubeconfig verified at %s against tenant API version %s is not working anymore: %s",
+			current.Annotations[annotation.KubeConfigCheckTime],
+			current.Annotations[annotation.KubeConfigServerVersion],
+			microerror.Cause(err),
+		),
+	)
+}
+
+// resetCheckFailure ends the failure period of the given tenant cluster after
+// a successful check.
+func (r *Resource) resetCheckFailure(cr apiv1alpha3.Cluster) {
+	r.mutex.Lock()
+	delete(r.failing, key.ClusterID(&cr))
+	r.mutex.Unlock()
+}
+
+// checkTime returns the check time to be recorded on the desired secret. The
+// time of the current secret is kept as long as neither the kubeconfig nor the
+// server version changed. This prevents the secret from being updated on every
+// reconciliation only because of a new check time.
+func checkTime(current *corev1.Secret, kubeConfig []byte, serverVersion string) string {
+	if current != nil {
+		t := current.Annotations[annotation.KubeConfigCheckTime]
+		v := current.Annotations[annotation.KubeConfigServerVersion]
+
+		if t != "" && v == serverVersion && bytes.Equal(current.Data["kubeConfig"], kubeConfig) {
+			return t
+		}
+	}
+
+	return time.Now().UTC().Format(time.RFC3339)
+}
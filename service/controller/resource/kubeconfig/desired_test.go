@@ -0,0 +1,174 @@
+package kubeconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclienttest"
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/resourcecanceledcontext"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+	tenantclientunittest "github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient/unittest"
+)
+
+func Test_Resource_GetDesiredState(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		statusCode            int
+		expectedCanceled      bool
+		expectedServerVersion string
+	}{
+		{
+			name:                  "case 0: tenant API accepts the generated kubeconfig",
+			statusCode:            http.StatusOK,
+			expectedCanceled:      false,
+			expectedServerVersion: "v1.18.19",
+		},
+		{
+			name:             "case 1: tenant API rejects the generated kubeconfig",
+			statusCode:       http.StatusUnauthorized,
+			expectedCanceled: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/version" || tc.statusCode != http.StatusOK {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"gitVersion":"v1.18.19"}`))
+			}))
+			defer server.Close()
+
+			crt, key := newTestKeyPair(t)
+
+			r := &Resource{
+				apiEndpoint: fakeAPIEndpoint{},
+				event:       recorder.New(recorder.Config{K8sClient: k8sclienttest.NewEmpty()}),
+				k8sClient:   fakek8s.NewSimpleClientset(),
+				logger:      microloggertest.New(),
+				tenant: fakeTenant{
+					restConfig: &rest.Config{
+						Host: server.URL,
+						TLSClientConfig: rest.TLSClientConfig{
+							CAData:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+							CertData: crt,
+							KeyData:  key,
+						},
+					},
+				},
+				tenantClient: tenantclientunittest.FakeTenantClient(nil),
+
+				failing:   map[string]bool{},
+				rotations: map[string]certInfo{},
+			}
+
+			cr := apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						label.Cluster:      "8y5ck",
+						label.Organization: "giantswarm",
+					},
+					Name:      "8y5ck",
+					Namespace: metav1.NamespaceDefault,
+				},
+			}
+
+			ctx := resourcecanceledcontext.NewContext(context.Background(), make(chan struct{}))
+
+			secrets, err := r.GetDesiredState(ctx, &cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			canceled := resourcecanceledcontext.IsCanceled(ctx)
+			if canceled != tc.expectedCanceled {
+				t.Fatalf("expected %t to be equal to %t", tc.expectedCanceled, canceled)
+			}
+
+			if tc.expectedCanceled {
+				if len(secrets) != 0 {
+					t.Fatalf("expected %d secrets, got %d", 0, len(secrets))
+				}
+				return
+			}
+
+			if len(secrets) != 1 {
+				t.Fatalf("expected %d secrets, got %d", 1, len(secrets))
+			}
+			v := secrets[0].Annotations[annotation.KubeConfigServerVersion]
+			if v != tc.expectedServerVersion {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedServerVersion, v)
+			}
+		})
+	}
+}
+
+type fakeAPIEndpoint struct{}
+
+func (f fakeAPIEndpoint) APIEndpoint(ctx context.Context, obj interface{}) (string, error) {
+	return "api.8y5ck.k8s.gauss.eu-central-1.aws.gigantic.io", nil
+}
+
+// fakeTenant returns the configured REST config regardless of the requested
+// endpoint, so that the generated kubeconfig points to the test server.
+type fakeTenant struct {
+	restConfig *rest.Config
+}
+
+func (f fakeTenant) NewRestConfig(ctx context.Context, clusterID, apiDomain string) (*rest.Config, error) {
+	return rest.CopyConfig(f.restConfig), nil
+}
+
+func newTestKeyPair(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(0xabc123),
+		Subject:      pkix.Name{CommonName: "app-operator"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crt := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	k := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b})
+
+	return crt, k
+}
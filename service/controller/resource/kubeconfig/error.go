@@ -13,6 +13,15 @@ func IsInvalidConfigError(err error) bool {
 	return microerror.Cause(err) == invalidConfigError
 }
 
+var notAvailableError = &microerror.Error{
+	Kind: "notAvailableError",
+}
+
+// IsNotAvailable asserts notAvailableError.
+func IsNotAvailable(err error) bool {
+	return microerror.Cause(err) == notAvailableError
+}
+
 var timeoutError = &microerror.Error{
 	Kind: "timeoutError",
 }
@@ -29,3 +38,12 @@ var wrongTypeError = &microerror.Error{
 func IsWrongTypeError(err error) bool {
 	return microerror.Cause(err) == wrongTypeError
 }
+
+var invalidCertError = &microerror.Error{
+	Kind: "invalidCertError",
+}
+
+// IsInvalidCert asserts invalidCertError.
+func IsInvalidCert(err error) bool {
+	return microerror.Cause(err) == invalidCertError
+}
@@ -1,13 +1,18 @@
 package kubeconfig
 
 import (
+	"sync"
+	"time"
+
 	"github.com/giantswarm/certs/v3/pkg/certs"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/tenantcluster/v4/pkg/tenantcluster"
 	"k8s.io/client-go/kubernetes"
 
-	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/apiendpoint"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
 )
 
 const (
@@ -15,22 +20,40 @@ const (
 	Name = "kubeconfig"
 )
 
+const (
+	// checkTimeout is the maximum duration the tenant API check may take
+	// before the generated kubeconfig is considered not working.
+	checkTimeout = 10 * time.Second
+)
+
 // Config represents the configuration used to create a new kubeconfig resource.
 type Config struct {
-	BaseDomain    basedomain.Interface
+	APIEndpoint   apiendpoint.Interface
 	CertsSearcher certs.Interface
+	Event         recorder.Interface
 	K8sClient     kubernetes.Interface
 	Logger        micrologger.Logger
 	Tenant        tenantcluster.Interface
+	TenantClient  tenantclient.Interface
 }
 
 // Resource implements the kubeconfig resource.
 type Resource struct {
-	baseDomain    basedomain.Interface
+	apiEndpoint   apiendpoint.Interface
 	certsSearcher certs.Interface
+	event         recorder.Interface
 	k8sClient     kubernetes.Interface
 	logger        micrologger.Logger
 	tenant        tenantcluster.Interface
+	tenantClient  tenantclient.Interface
+
+	mutex sync.Mutex
+	// failing holds the cluster IDs of tenant clusters whose published
+	// kubeconfig failed the last tenant API check.
+	failing map[string]bool
+	// rotations holds the certificates of tenant clusters whose kubeconfig
+	// has to be published again because of a reissued certificate.
+	rotations map[string]certInfo
 }
 
 // New creates a new configured secret state getter resource managing kube
@@ -39,12 +62,15 @@ type Resource struct {
 //     https://pkg.go.dev/github.com/giantswarm/operatorkit/v5/pkg/resource/k8s/secretresource#StateGetter
 //
 func New(config Config) (*Resource, error) {
-	if config.BaseDomain == nil {
-		return nil, microerror.Maskf(invalidConfigError, "%T.BaseDomain must not be empty", config)
+	if config.APIEndpoint == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.APIEndpoint must not be empty", config)
 	}
 	if config.CertsSearcher == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.CertsSearcher must not be empty", config)
 	}
+	if config.Event == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Event must not be empty", config)
+	}
 	if config.K8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
 	}
@@ -54,13 +80,21 @@ func New(config Config) (*Resource, error) {
 	if config.Tenant == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Tenant must not be empty", config)
 	}
+	if config.TenantClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TenantClient must not be empty", config)
+	}
 
 	r := &Resource{
-		baseDomain:    config.BaseDomain,
+		apiEndpoint:   config.APIEndpoint,
 		certsSearcher: config.CertsSearcher,
+		event:         config.Event,
 		k8sClient:     config.K8sClient,
 		logger:        config.Logger,
 		tenant:        config.Tenant,
+		tenantClient:  config.TenantClient,
+
+		failing:   map[string]bool{},
+		rotations: map[string]certInfo{},
 	}
 
 	return r, nil
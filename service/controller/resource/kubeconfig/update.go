@@ -0,0 +1,90 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/resource/crud"
+	corev1 "k8s.io/api/core/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+// updateCRUD wraps the CRUD operations of the secret resource publishing the
+// kubeconfig, so that rotations are announced after the updated kubeconfig
+// secret got published.
+type updateCRUD struct {
+	crud.Interface
+
+	resource *Resource
+}
+
+// WrapCRUD returns the given CRUD operations of the secret resource managing
+// kubeconfigs, emitting the KubeConfigCertRotated event once the kubeconfig
+// of a reissued certificate got published.
+func (r *Resource) WrapCRUD(ops crud.Interface) crud.Interface {
+	return &updateCRUD{
+		Interface: ops,
+		resource:  r,
+	}
+}
+
+func (u *updateCRUD) ApplyUpdateChange(ctx context.Context, obj, updateChange interface{}) error {
+	err := u.Interface.ApplyUpdateChange(ctx, obj, updateChange)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	cr, err := key.ToCluster(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	secrets, ok := updateChange.([]*corev1.Secret)
+	if !ok {
+		return microerror.Maskf(wrongTypeError, "expected '%T', got '%T'", []*corev1.Secret{}, updateChange)
+	}
+
+	for _, s := range secrets {
+		ci, ok := u.resource.publishedRotation(cr, s)
+		if !ok {
+			continue
+		}
+
+		u.resource.event.Emit(ctx, &cr, "KubeConfigCertRotated",
+			fmt.Sprintf("regenerated kubeconfig for reissued certificate with serial %s expiring at %s", ci.Serial, ci.Expiry.Format(time.RFC3339)),
+		)
+	}
+
+	return nil
+}
+
+// recordRotation records the reissued certificate of the given tenant cluster
+// until the kubeconfig derived from it got published.
+func (r *Resource) recordRotation(cr apiv1alpha3.Cluster, ci certInfo) {
+	r.mutex.Lock()
+	r.rotations[key.ClusterID(&cr)] = ci
+	r.mutex.Unlock()
+}
+
+// publishedRotation returns the recorded reissued certificate of the given
+// tenant cluster in case the given published secret was derived from it. The
+// recorded certificate is dropped in this case, so that the rotation is only
+// announced once.
+func (r *Resource) publishedRotation(cr apiv1alpha3.Cluster, secret *corev1.Secret) (certInfo, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	ci, ok := r.rotations[key.ClusterID(&cr)]
+	if !ok || secret.Annotations[annotation.KubeConfigCertSerial] != ci.Serial {
+		return certInfo{}, false
+	}
+
+	delete(r.rotations, key.ClusterID(&cr))
+
+	return ci, true
+}
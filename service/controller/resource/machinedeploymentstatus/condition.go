@@ -0,0 +1,77 @@
+package machinedeploymentstatus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
+)
+
+// ensureNodePoolsReadyCondition reflects the readiness of all node pools of
+// the tenant cluster in the NodePoolsReady condition of the Cluster CR. The
+// v1alpha3 MachineDeployment status does not provide conditions, which is why
+// the readiness of all node pools is aggregated on the Cluster CR.
+func (r *Resource) ensureNodePoolsReadyCondition(ctx context.Context, cr *apiv1alpha3.MachineDeployment, cl apiv1alpha3.Cluster, workerCount map[string]nodecount.Node) error {
+	var mdList apiv1alpha3.MachineDeploymentList
+	{
+		err := r.k8sClient.CtrlClient().List(
+			ctx,
+			&mdList,
+			client.InNamespace(cr.Namespace),
+			client.MatchingLabels{label.Cluster: key.ClusterID(cr)},
+		)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	updated := cl.DeepCopy()
+	conditions.Set(updated, nodePoolsReadyCondition(mdList.Items, workerCount))
+
+	if !key.ConditionsEqual(cl.GetConditions(), updated.GetConditions()) {
+		r.logger.Debugf(ctx, "updating %#q condition of cluster %#q", condition.NodePoolsReady, key.ClusterID(cr))
+
+		err := r.k8sClient.CtrlClient().Status().Update(ctx, updated)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated %#q condition of cluster %#q", condition.NodePoolsReady, key.ClusterID(cr))
+	}
+
+	return nil
+}
+
+func nodePoolsReadyCondition(machineDeployments []apiv1alpha3.MachineDeployment, workerCount map[string]nodecount.Node) *apiv1alpha3.Condition {
+	var notReady []string
+	for _, md := range machineDeployments {
+		id := md.Labels[label.MachineDeployment]
+		n := workerCount[id]
+
+		if n.Ready < n.Nodes {
+			notReady = append(notReady, fmt.Sprintf("%s (%d/%d)", id, n.Ready, n.Nodes))
+		}
+	}
+
+	if len(notReady) > 0 {
+		sort.Strings(notReady)
+		return conditions.FalseCondition(
+			condition.NodePoolsReady,
+			condition.NodePoolsNotReadyReason,
+			apiv1alpha3.ConditionSeverityWarning,
+			"node pools with worker nodes not ready: %s", strings.Join(notReady, ", "),
+		)
+	}
+
+	return conditions.TrueCondition(condition.NodePoolsReady)
+}
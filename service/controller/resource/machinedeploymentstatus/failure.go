@@ -0,0 +1,69 @@
+package machinedeploymentstatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/microerror"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+)
+
+// ensureFailure reflects terminal errors of the tenant cluster in the failure
+// annotations of the given MachineDeployment CR, since node pools of tenant
+// clusters with e.g. an invalid configuration cannot be provisioned either.
+// The v1alpha3 MachineDeployment status does not provide failure fields. The
+// given Cluster CR is nil in case it does not exist anymore. It is returned
+// whether the node pool is failed.
+func (r *Resource) ensureFailure(ctx context.Context, cr *apiv1alpha3.MachineDeployment, cl *apiv1alpha3.Cluster) (bool, error) {
+	reason, message := machineDeploymentFailure(cl)
+
+	if cr.Annotations[annotation.FailureReason] == reason && cr.Annotations[annotation.FailureMessage] == message {
+		return reason != "", nil
+	}
+
+	{
+		r.logger.Debugf(ctx, "updating failure of machine deployment")
+
+		if cr.Annotations == nil {
+			cr.Annotations = map[string]string{}
+		}
+		if reason == "" {
+			delete(cr.Annotations, annotation.FailureReason)
+			delete(cr.Annotations, annotation.FailureMessage)
+		} else {
+			cr.Annotations[annotation.FailureReason] = reason
+			cr.Annotations[annotation.FailureMessage] = message
+		}
+
+		err := r.k8sClient.CtrlClient().Update(ctx, cr)
+		if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated failure of machine deployment")
+
+		if reason != "" {
+			r.event.EmitWarning(ctx, cr, "MachineDeploymentFailed", fmt.Sprintf("node pool failed with %s: %s", reason, message))
+		}
+	}
+
+	return reason != "", nil
+}
+
+// machineDeploymentFailure returns the failure reason and message of a node
+// pool of the given tenant cluster. Both are empty as long as the tenant
+// cluster has no terminal error.
+func machineDeploymentFailure(cl *apiv1alpha3.Cluster) (string, string) {
+	if cl == nil || cl.Status.FailureReason == nil {
+		return "", ""
+	}
+
+	var message string
+	if cl.Status.FailureMessage != nil {
+		message = fmt.Sprintf("tenant cluster failed: %s", *cl.Status.FailureMessage)
+	}
+
+	return string(*cl.Status.FailureReason), message
+}
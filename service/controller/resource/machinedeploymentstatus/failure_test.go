@@ -0,0 +1,56 @@
+package machinedeploymentstatus
+
+import (
+	"strconv"
+	"testing"
+
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+)
+
+func Test_machineDeploymentFailure(t *testing.T) {
+	reason := capierrors.InvalidConfigurationClusterError
+	message := "invalid cluster IP range"
+
+	testCases := []struct {
+		name            string
+		cluster         *apiv1alpha3.Cluster
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:    "case 0: cluster does not exist",
+			cluster: nil,
+		},
+		{
+			name:    "case 1: cluster is not failed",
+			cluster: &apiv1alpha3.Cluster{},
+		},
+		{
+			name: "case 2: cluster is failed",
+			cluster: &apiv1alpha3.Cluster{
+				Status: apiv1alpha3.ClusterStatus{
+					FailureReason:  &reason,
+					FailureMessage: &message,
+				},
+			},
+			expectedReason:  "InvalidConfiguration",
+			expectedMessage: "tenant cluster failed: invalid cluster IP range",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			r, m := machineDeploymentFailure(tc.cluster)
+
+			if r != tc.expectedReason {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedReason, r)
+			}
+			if m != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, m)
+			}
+		})
+	}
+}
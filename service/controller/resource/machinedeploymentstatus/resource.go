@@ -3,13 +3,16 @@ package machinedeploymentstatus
 import (
 	"context"
 	"fmt"
+	"reflect"
 
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/finalizerskeptcontext"
 	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
 	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/resourcecanceledcontext"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
 )
 
@@ -26,17 +30,25 @@ const (
 )
 
 type Config struct {
-	Event     recorder.Interface
-	K8sClient k8sclient.Interface
-	Logger    micrologger.Logger
-	NodeCount nodecount.Interface
+	Event          recorder.Interface
+	K8sClient      k8sclient.Interface
+	Logger         micrologger.Logger
+	NodeCount      nodecount.Interface
+	ReleaseVersion releaseversion.Interface
+
+	NewCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	Provider                   string
 }
 
 type Resource struct {
-	event     recorder.Interface
-	k8sClient k8sclient.Interface
-	logger    micrologger.Logger
-	nodeCount nodecount.Interface
+	event          recorder.Interface
+	k8sClient      k8sclient.Interface
+	logger         micrologger.Logger
+	nodeCount      nodecount.Interface
+	releaseVersion releaseversion.Interface
+
+	newCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
+	provider                   string
 }
 
 func New(config Config) (*Resource, error) {
@@ -52,12 +64,26 @@ func New(config Config) (*Resource, error) {
 	if config.NodeCount == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.NodeCount must not be empty", config)
 	}
+	if config.ReleaseVersion == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ReleaseVersion must not be empty", config)
+	}
+
+	if config.NewCommonClusterObjectFunc == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.NewCommonClusterObjectFunc must not be empty", config)
+	}
+	if config.Provider == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Provider must not be empty", config)
+	}
 
 	r := &Resource{
-		event:     config.Event,
-		k8sClient: config.K8sClient,
-		logger:    config.Logger,
-		nodeCount: config.NodeCount,
+		event:          config.Event,
+		k8sClient:      config.K8sClient,
+		logger:         config.Logger,
+		nodeCount:      config.NodeCount,
+		releaseVersion: config.ReleaseVersion,
+
+		newCommonClusterObjectFunc: config.NewCommonClusterObjectFunc,
+		provider:                   config.Provider,
 	}
 
 	return r, nil
@@ -100,13 +126,46 @@ func (r *Resource) ensure(ctx context.Context, obj interface{}) error {
 	} else if err != nil {
 		return microerror.Mask(err)
 	}
+
+	var cl *apiv1alpha3.Cluster
 	{
-		r.logger.Debugf(ctx, "checking if status of machine deployment needs to be updated")
+		cl = &apiv1alpha3.Cluster{}
+		err := r.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: key.ClusterID(cr), Namespace: cr.Namespace}, cl)
+		if apierrors.IsNotFound(err) {
+			r.logger.Debugf(ctx, "did not find cluster %#q", key.ClusterID(cr))
+			cl = nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	if cl != nil {
+		err = r.ensureNodePoolsReadyCondition(ctx, cr, *cl, workerCount)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	failed, err := r.ensureFailure(ctx, cr, cl)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// The upgrade progress is not tracked during deletion because the Release CR
+	// the desired provider operator version is taken from might be gone already.
+	updatedReplicas := cr.Status.UpdatedReplicas
+	if !key.IsDeleted(cr) {
+		updatedReplicas, err = r.ensureUpgradeProgress(ctx, cr, workerCount[cr.Labels[label.MachineDeployment]])
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
 
-		replicasChanged := cr.Status.Replicas != workerCount[cr.Labels[label.MachineDeployment]].Nodes
-		readyReplicasChanged := cr.Status.ReadyReplicas != workerCount[cr.Labels[label.MachineDeployment]].Ready
+	status := machineDeploymentStatus(*cr, workerCount[cr.Labels[label.MachineDeployment]], updatedReplicas, failed)
+	{
+		r.logger.Debugf(ctx, "checking if status of machine deployment needs to be updated")
 
-		if !replicasChanged && !readyReplicasChanged {
+		if reflect.DeepEqual(cr.Status, status) {
 			r.logger.Debugf(ctx, "status of machine deployment does not need to be updated")
 			return nil
 		}
@@ -115,8 +174,7 @@ func (r *Resource) ensure(ctx context.Context, obj interface{}) error {
 	}
 
 	{
-		cr.Status.Replicas = workerCount[cr.Labels[label.MachineDeployment]].Nodes
-		cr.Status.ReadyReplicas = workerCount[cr.Labels[label.MachineDeployment]].Ready
+		cr.Status = status
 	}
 
 	{
@@ -0,0 +1,50 @@
+package machinedeploymentstatus
+
+import (
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
+)
+
+// machineDeploymentStatus computes the desired status of the given
+// MachineDeployment CR from the nodes of its node pool. Ready nodes are
+// considered available. In case the MachineDeployment does not specify desired
+// replicas, e.g. because the node pool is autoscaled, the current number of
+// nodes is desired. Node pools of failed tenant clusters are failed as well.
+// The v1alpha3 API does not know about a deleting phase, which is why deleted
+// node pools are scaling down.
+func machineDeploymentStatus(cr apiv1alpha3.MachineDeployment, node nodecount.Node, updatedReplicas int32, failed bool) apiv1alpha3.MachineDeploymentStatus {
+	desiredReplicas := node.Nodes
+	if cr.Spec.Replicas != nil {
+		desiredReplicas = *cr.Spec.Replicas
+	}
+
+	desired := *cr.Status.DeepCopy()
+	{
+		desired.ObservedGeneration = cr.Generation
+		desired.Replicas = node.Nodes
+		desired.ReadyReplicas = node.Ready
+		desired.UpdatedReplicas = updatedReplicas
+		desired.AvailableReplicas = node.Ready
+		desired.UnavailableReplicas = 0
+		if node.Ready < desiredReplicas {
+			desired.UnavailableReplicas = desiredReplicas - node.Ready
+		}
+	}
+
+	switch {
+	case key.IsDeleted(&cr):
+		desired.SetTypedPhase(apiv1alpha3.MachineDeploymentPhaseScalingDown)
+	case failed:
+		desired.SetTypedPhase(apiv1alpha3.MachineDeploymentPhaseFailed)
+	case node.Nodes > desiredReplicas:
+		desired.SetTypedPhase(apiv1alpha3.MachineDeploymentPhaseScalingDown)
+	case node.Nodes < desiredReplicas || node.Ready < node.Nodes:
+		desired.SetTypedPhase(apiv1alpha3.MachineDeploymentPhaseScalingUp)
+	default:
+		desired.SetTypedPhase(apiv1alpha3.MachineDeploymentPhaseRunning)
+	}
+
+	return desired
+}
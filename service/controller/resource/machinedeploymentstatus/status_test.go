@@ -0,0 +1,127 @@
+package machinedeploymentstatus
+
+import (
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
+)
+
+func Test_machineDeploymentStatus(t *testing.T) {
+	testCases := []struct {
+		name                        string
+		replicas                    *int32
+		deleted                     bool
+		failed                      bool
+		node                        nodecount.Node
+		expectedPhase               apiv1alpha3.MachineDeploymentPhase
+		expectedAvailableReplicas   int32
+		expectedUnavailableReplicas int32
+	}{
+		{
+			name:                        "case 0: node pool is scaling up",
+			replicas:                    toInt32P(3),
+			node:                        nodecount.Node{Nodes: 1, Ready: 1},
+			expectedPhase:               apiv1alpha3.MachineDeploymentPhaseScalingUp,
+			expectedAvailableReplicas:   1,
+			expectedUnavailableReplicas: 2,
+		},
+		{
+			name:                        "case 1: nodes of node pool are not ready",
+			replicas:                    toInt32P(3),
+			node:                        nodecount.Node{Nodes: 3, Ready: 2},
+			expectedPhase:               apiv1alpha3.MachineDeploymentPhaseScalingUp,
+			expectedAvailableReplicas:   2,
+			expectedUnavailableReplicas: 1,
+		},
+		{
+			name:                        "case 2: node pool is scaling down",
+			replicas:                    toInt32P(2),
+			node:                        nodecount.Node{Nodes: 3, Ready: 3},
+			expectedPhase:               apiv1alpha3.MachineDeploymentPhaseScalingDown,
+			expectedAvailableReplicas:   3,
+			expectedUnavailableReplicas: 0,
+		},
+		{
+			name:                        "case 3: node pool is running",
+			replicas:                    toInt32P(3),
+			node:                        nodecount.Node{Nodes: 3, Ready: 3},
+			expectedPhase:               apiv1alpha3.MachineDeploymentPhaseRunning,
+			expectedAvailableReplicas:   3,
+			expectedUnavailableReplicas: 0,
+		},
+		{
+			name:                        "case 4: node pool without desired replicas is running",
+			node:                        nodecount.Node{Nodes: 2, Ready: 2},
+			expectedPhase:               apiv1alpha3.MachineDeploymentPhaseRunning,
+			expectedAvailableReplicas:   2,
+			expectedUnavailableReplicas: 0,
+		},
+		{
+			name:                        "case 5: node pool is deleted",
+			replicas:                    toInt32P(3),
+			deleted:                     true,
+			node:                        nodecount.Node{Nodes: 1, Ready: 1},
+			expectedPhase:               apiv1alpha3.MachineDeploymentPhaseScalingDown,
+			expectedAvailableReplicas:   1,
+			expectedUnavailableReplicas: 2,
+		},
+		{
+			name:                        "case 6: node pool of failed cluster",
+			replicas:                    toInt32P(3),
+			failed:                      true,
+			node:                        nodecount.Node{Nodes: 3, Ready: 3},
+			expectedPhase:               apiv1alpha3.MachineDeploymentPhaseFailed,
+			expectedAvailableReplicas:   3,
+			expectedUnavailableReplicas: 0,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			cr := apiv1alpha3.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 2,
+				},
+				Spec: apiv1alpha3.MachineDeploymentSpec{
+					Replicas: tc.replicas,
+				},
+			}
+			if tc.deleted {
+				now := metav1.Now()
+				cr.DeletionTimestamp = &now
+			}
+
+			status := machineDeploymentStatus(cr, tc.node, 1, tc.failed)
+
+			if apiv1alpha3.MachineDeploymentPhase(status.Phase) != tc.expectedPhase {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedPhase, status.Phase)
+			}
+			if status.Replicas != tc.node.Nodes {
+				t.Fatalf("expected %d to be equal to %d", tc.node.Nodes, status.Replicas)
+			}
+			if status.ReadyReplicas != tc.node.Ready {
+				t.Fatalf("expected %d to be equal to %d", tc.node.Ready, status.ReadyReplicas)
+			}
+			if status.UpdatedReplicas != 1 {
+				t.Fatalf("expected %d to be equal to %d", 1, status.UpdatedReplicas)
+			}
+			if status.AvailableReplicas != tc.expectedAvailableReplicas {
+				t.Fatalf("expected %d to be equal to %d", tc.expectedAvailableReplicas, status.AvailableReplicas)
+			}
+			if status.UnavailableReplicas != tc.expectedUnavailableReplicas {
+				t.Fatalf("expected %d to be equal to %d", tc.expectedUnavailableReplicas, status.UnavailableReplicas)
+			}
+			if status.ObservedGeneration != 2 {
+				t.Fatalf("expected %d to be equal to %d", 2, status.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func toInt32P(v int32) *int32 {
+	return &v
+}
@@ -0,0 +1,23 @@
+package nodeinformer
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	cr, err := key.ToCluster(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.tenantInformer.Stop(ctx, &cr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package nodeinformer
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantinformer"
+)
+
+const (
+	Name = "nodeinformer"
+)
+
+type Config struct {
+	Logger         micrologger.Logger
+	TenantInformer tenantinformer.Interface
+}
+
+// Resource stops the Node informer of a tenant cluster once its Cluster CR is
+// deleted. Informers are started lazily by the resources consuming nodes.
+type Resource struct {
+	logger         micrologger.Logger
+	tenantInformer tenantinformer.Interface
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.TenantInformer == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TenantInformer must not be empty", config)
+	}
+
+	r := &Resource{
+		logger:         config.Logger,
+		tenantInformer: config.TenantInformer,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
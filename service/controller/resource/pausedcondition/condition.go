@@ -0,0 +1,33 @@
+package pausedcondition
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/annotations"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+)
+
+// pausedCondition computes the Paused condition of the given Cluster CR. Nil
+// is returned for Cluster CRs which are not paused, in which case the
+// condition is removed.
+func pausedCondition(cl apiv1alpha3.Cluster) *apiv1alpha3.Condition {
+	var message string
+	if cl.Spec.Paused {
+		message = "reconciliation is paused by the paused flag of the Cluster CR"
+	} else if annotations.HasPausedAnnotation(&cl) {
+		message = fmt.Sprintf("reconciliation is paused by annotation %#q", apiv1alpha3.PausedAnnotation)
+	} else {
+		return nil
+	}
+
+	c := &apiv1alpha3.Condition{
+		Type:    condition.Paused,
+		Status:  corev1.ConditionTrue,
+		Message: message,
+	}
+
+	return c
+}
@@ -0,0 +1,80 @@
+package pausedcondition
+
+import (
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+)
+
+func Test_pausedCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		cluster         apiv1alpha3.Cluster
+		expectedNil     bool
+		expectedMessage string
+	}{
+		{
+			name: "case 0: unpaused cluster has no condition",
+			cluster: apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "8y5ck",
+				},
+			},
+			expectedNil: true,
+		},
+		{
+			name: "case 1: cluster paused by flag",
+			cluster: apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "8y5ck",
+				},
+				Spec: apiv1alpha3.ClusterSpec{
+					Paused: true,
+				},
+			},
+			expectedMessage: "reconciliation is paused by the paused flag of the Cluster CR",
+		},
+		{
+			name: "case 2: cluster paused by annotation",
+			cluster: apiv1alpha3.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "8y5ck",
+					Annotations: map[string]string{
+						apiv1alpha3.PausedAnnotation: "",
+					},
+				},
+			},
+			expectedMessage: "reconciliation is paused by annotation `cluster.x-k8s.io/paused`",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c := pausedCondition(tc.cluster)
+
+			if tc.expectedNil {
+				if c != nil {
+					t.Fatalf("expected %#v to be equal to %#v", nil, c)
+				}
+				return
+			}
+
+			if c.Type != condition.Paused {
+				t.Fatalf("expected %#q to be equal to %#q", condition.Paused, c.Type)
+			}
+			if c.Status != corev1.ConditionTrue {
+				t.Fatalf("expected %#q to be equal to %#q", corev1.ConditionTrue, c.Status)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
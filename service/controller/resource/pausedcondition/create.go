@@ -0,0 +1,54 @@
+package pausedcondition
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	cl, err := key.ToCluster(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	updated := cl.DeepCopy()
+	{
+		c := pausedCondition(cl)
+		if c != nil {
+			conditions.Set(updated, c)
+		} else {
+			conditions.Delete(updated, condition.Paused)
+		}
+	}
+
+	if key.ConditionsEqual(cl.GetConditions(), updated.GetConditions()) {
+		return nil
+	}
+
+	r.logger.Debugf(ctx, "updating %#q condition", condition.Paused)
+
+	err = r.k8sClient.CtrlClient().Status().Update(ctx, updated)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	r.logger.Debugf(ctx, "updated %#q condition", condition.Paused)
+
+	if conditions.IsTrue(updated, condition.Paused) {
+		r.event.Emit(ctx, &cl, "ClusterPaused", conditions.GetMessage(updated, condition.Paused))
+	} else {
+		r.event.Emit(ctx, &cl, "ClusterResumed", "reconciliation is resumed")
+	}
+
+	// Following resources would otherwise work with an outdated object.
+	r.logger.Debugf(ctx, "canceling reconciliation")
+	reconciliationcanceledcontext.SetCanceled(ctx)
+
+	return nil
+}
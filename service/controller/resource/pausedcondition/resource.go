@@ -0,0 +1,52 @@
+package pausedcondition
+
+import (
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
+)
+
+const (
+	Name = "pausedcondition"
+)
+
+type Config struct {
+	Event     recorder.Interface
+	K8sClient k8sclient.Interface
+	Logger    micrologger.Logger
+}
+
+// Resource maintains the Paused condition of Cluster CRs. Other than most
+// resources of the cluster controller it must not be wrapped with a paused
+// resource, since it has to be executed for paused tenant clusters as well.
+type Resource struct {
+	event     recorder.Interface
+	k8sClient k8sclient.Interface
+	logger    micrologger.Logger
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Event == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Event must not be empty", config)
+	}
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	r := &Resource{
+		event:     config.Event,
+		k8sClient: config.K8sClient,
+		logger:    config.Logger,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) Name() string {
+	return Name
+}
@@ -0,0 +1,215 @@
+package statuscondition
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	corev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/core/v1alpha1"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+const (
+	appStatusDeployed = "deployed"
+)
+
+// summarizedConditions are the conditions the Ready condition of the Cluster
+// CR is computed from. ControlPlaneReady and NodePoolsReady are computed by the
+// control plane and machine deployment controllers.
+var summarizedConditions = []apiv1alpha3.ConditionType{
+	condition.AppsReady,
+	condition.CertificatesReady,
+	condition.ClusterNetworkValid,
+	condition.ControlPlaneReady,
+	condition.NodePoolsReady,
+}
+
+// ensureClusterConditions computes the Cluster API conditions of the given
+// Cluster CR so that standard Cluster API tooling can inspect our tenant
+// clusters.
+func (r *Resource) ensureClusterConditions(ctx context.Context, cl apiv1alpha3.Cluster, degraded *apiv1alpha3.Condition, stuck *apiv1alpha3.Condition, tenantAPI *apiv1alpha3.Condition, hibernated *apiv1alpha3.Condition) error {
+	var apps []applicationv1alpha1.App
+	{
+		r.logger.Debugf(ctx, "finding apps for tenant cluster")
+
+		list, err := r.k8sClient.G8sClient().ApplicationV1alpha1().Apps(key.ClusterID(&cl)).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		apps = list.Items
+
+		r.logger.Debugf(ctx, "found %d apps for tenant cluster", len(apps))
+	}
+
+	var certConfigs []corev1alpha1.CertConfig
+	{
+		r.logger.Debugf(ctx, "finding CertConfigs for tenant cluster")
+
+		o := metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", label.Cluster, key.ClusterID(&cl)),
+		}
+		list, err := r.k8sClient.G8sClient().CoreV1alpha1().CertConfigs(cl.Namespace).List(ctx, o)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		certConfigs = list.Items
+
+		r.logger.Debugf(ctx, "found %d CertConfigs for tenant cluster", len(certConfigs))
+	}
+
+	var secrets []corev1.Secret
+	{
+		r.logger.Debugf(ctx, "finding certificate secrets for tenant cluster")
+
+		o := metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s,%s", label.Cluster, key.ClusterID(&cl), label.Certificate),
+		}
+		list, err := r.k8sClient.K8sClient().CoreV1().Secrets(metav1.NamespaceAll).List(ctx, o)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		secrets = list.Items
+
+		r.logger.Debugf(ctx, "found %d certificate secrets for tenant cluster", len(secrets))
+	}
+
+	updated := cl.DeepCopy()
+	{
+		conditions.Set(updated, appsReadyCondition(apps))
+		conditions.Set(updated, certificatesReadyCondition(certConfigs, secrets))
+		conditions.Set(updated, degraded)
+		if stuck != nil {
+			conditions.Set(updated, stuck)
+		}
+		conditions.Set(updated, tenantAPI)
+		if hibernated != nil {
+			conditions.Set(updated, hibernated)
+		} else {
+			conditions.Delete(updated, condition.Hibernated)
+		}
+
+		for _, t := range summarizedConditions {
+			if !conditions.Has(updated, t) {
+				conditions.MarkUnknown(updated, t, condition.WaitingForStatusReason, "condition was not computed yet")
+			}
+		}
+
+		conditions.SetSummary(updated, conditions.WithConditions(summarizedConditions...))
+	}
+
+	if !key.ConditionsEqual(cl.GetConditions(), updated.GetConditions()) {
+		r.logger.Debugf(ctx, "updating cluster conditions")
+
+		err := r.k8sClient.CtrlClient().Status().Update(ctx, updated)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated cluster conditions")
+
+		wasDegraded := conditions.IsTrue(&cl, condition.Degraded)
+		isDegraded := conditions.IsTrue(updated, condition.Degraded)
+
+		if !wasDegraded && isDegraded {
+			r.event.EmitWarning(ctx, &cl, "ClusterDegraded", fmt.Sprintf("cluster is degraded: %s", degraded.Message))
+		}
+		if wasDegraded && !isDegraded {
+			r.event.Emit(ctx, &cl, "ClusterRecovered", "cluster is not degraded anymore")
+		}
+
+		if !conditions.IsTrue(&cl, condition.Stuck) && conditions.IsTrue(updated, condition.Stuck) {
+			r.event.EmitWarning(ctx, &cl, "ClusterStuck", stuck.Message)
+		}
+
+		if conditions.GetReason(&cl, condition.TenantAPIAvailable) != condition.CircuitBreakerOpenReason && conditions.GetReason(updated, condition.TenantAPIAvailable) == condition.CircuitBreakerOpenReason {
+			r.event.EmitWarning(ctx, &cl, "TenantAPIUnavailable", tenantAPI.Message)
+		}
+		if conditions.IsFalse(&cl, condition.TenantAPIAvailable) && conditions.IsTrue(updated, condition.TenantAPIAvailable) {
+			r.event.Emit(ctx, &cl, "TenantAPIAvailable", "tenant API is reachable again")
+		}
+
+		if !conditions.IsTrue(&cl, condition.Hibernated) && conditions.IsTrue(updated, condition.Hibernated) {
+			r.event.Emit(ctx, &cl, "ClusterHibernated", "all node pools are scaled to zero")
+		}
+		if conditions.Has(&cl, condition.Hibernated) && !conditions.Has(updated, condition.Hibernated) {
+			r.event.Emit(ctx, &cl, "ClusterRestored", "all node pools are restored and ready")
+		}
+	}
+
+	return nil
+}
+
+func appsReadyCondition(apps []applicationv1alpha1.App) *apiv1alpha3.Condition {
+	if len(apps) == 0 {
+		return conditions.FalseCondition(
+			condition.AppsReady,
+			condition.AppsNotDeployedReason,
+			apiv1alpha3.ConditionSeverityInfo,
+			"no apps created yet",
+		)
+	}
+
+	var notDeployed []string
+	for _, a := range apps {
+		if !strings.EqualFold(a.Status.Release.Status, appStatusDeployed) {
+			notDeployed = append(notDeployed, a.Name)
+		}
+	}
+
+	if len(notDeployed) > 0 {
+		sort.Strings(notDeployed)
+		return conditions.FalseCondition(
+			condition.AppsReady,
+			condition.AppsNotDeployedReason,
+			apiv1alpha3.ConditionSeverityWarning,
+			"apps not deployed: %s", strings.Join(notDeployed, ", "),
+		)
+	}
+
+	return conditions.TrueCondition(condition.AppsReady)
+}
+
+func certificatesReadyCondition(certConfigs []corev1alpha1.CertConfig, secrets []corev1.Secret) *apiv1alpha3.Condition {
+	issued := map[string]bool{}
+	for _, s := range secrets {
+		issued[s.Labels[label.Certificate]] = true
+	}
+
+	if len(certConfigs) == 0 {
+		return conditions.FalseCondition(
+			condition.CertificatesReady,
+			condition.CertificatesNotIssuedReason,
+			apiv1alpha3.ConditionSeverityInfo,
+			"no CertConfigs created yet",
+		)
+	}
+
+	var missing []string
+	for _, c := range certConfigs {
+		if !issued[c.Labels[label.Certificate]] {
+			missing = append(missing, c.Labels[label.Certificate])
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return conditions.FalseCondition(
+			condition.CertificatesReady,
+			condition.CertificatesNotIssuedReason,
+			apiv1alpha3.ConditionSeverityWarning,
+			"certificates not issued: %s", strings.Join(missing, ", "),
+		)
+	}
+
+	return conditions.TrueCondition(condition.CertificatesReady)
+}
@@ -0,0 +1,128 @@
+package statuscondition
+
+import (
+	"strconv"
+	"testing"
+
+	applicationv1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/application/v1alpha1"
+	corev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+)
+
+func Test_appsReadyCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		apps            []applicationv1alpha1.App
+		expectedStatus  corev1.ConditionStatus
+		expectedMessage string
+	}{
+		{
+			name:            "case 0: no apps",
+			apps:            nil,
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "no apps created yet",
+		},
+		{
+			name: "case 1: all apps deployed",
+			apps: []applicationv1alpha1.App{
+				newApp("coredns", "deployed"),
+				newApp("cert-exporter", "DEPLOYED"),
+			},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "case 2: apps not deployed",
+			apps: []applicationv1alpha1.App{
+				newApp("net-exporter", "failed"),
+				newApp("coredns", "deployed"),
+				newApp("cert-exporter", ""),
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "apps not deployed: cert-exporter, net-exporter",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			c := appsReadyCondition(tc.apps)
+
+			if c.Status != tc.expectedStatus {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedStatus, c.Status)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
+
+func Test_certificatesReadyCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		certConfigs     []string
+		secrets         []string
+		expectedStatus  corev1.ConditionStatus
+		expectedMessage string
+	}{
+		{
+			name:            "case 0: no CertConfigs",
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "no CertConfigs created yet",
+		},
+		{
+			name:           "case 1: all certificates issued",
+			certConfigs:    []string{"api", "worker"},
+			secrets:        []string{"worker", "api"},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name:            "case 2: certificates missing",
+			certConfigs:     []string{"api", "worker", "etcd"},
+			secrets:         []string{"api"},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedMessage: "certificates not issued: etcd, worker",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var certConfigs []corev1alpha1.CertConfig
+			for _, c := range tc.certConfigs {
+				certConfigs = append(certConfigs, corev1alpha1.CertConfig{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{label.Certificate: c}},
+				})
+			}
+			var secrets []corev1.Secret
+			for _, s := range tc.secrets {
+				secrets = append(secrets, corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{label.Certificate: s}},
+				})
+			}
+
+			c := certificatesReadyCondition(certConfigs, secrets)
+
+			if c.Status != tc.expectedStatus {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedStatus, c.Status)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
+
+func newApp(name string, status string) applicationv1alpha1.App {
+	return applicationv1alpha1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: applicationv1alpha1.AppStatus{
+			Release: applicationv1alpha1.AppStatusRelease{
+				Status: status,
+			},
+		},
+	}
+}
@@ -4,19 +4,22 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/errors/tenant"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/reconciliationcanceledcontext"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
 	"github.com/giantswarm/cluster-operator/v3/pkg/label"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
 )
 
@@ -58,29 +61,20 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		r.logger.Debugf(ctx, "found cluster")
 	}
 
-	tenantClient, err := r.tenantClient.K8sClient(ctx, cr)
+	r.logger.Debugf(ctx, "finding nodes of tenant cluster")
+
+	nodes, err := r.tenantInformer.Nodes(ctx, cr)
 	if tenantclient.IsNotAvailable(err) {
 		r.logger.Debugf(ctx, "tenant client is not available yet")
+	} else if tenant.IsAPINotAvailable(err) {
+		// During cluster creation / upgrade the tenant API is naturally not
+		// available but this resource must still continue execution as that's
+		// when `Creating` and `Upgrading` conditions may need to be applied.
+		r.logger.Debugf(ctx, "tenant API not available yet")
 	} else if err != nil {
 		return microerror.Mask(err)
-	}
-
-	var nodes []corev1.Node
-	if tenantClient != nil {
-		r.logger.Debugf(ctx, "finding nodes of tenant cluster")
-		l, err := tenantClient.K8sClient().CoreV1().Nodes().List(ctx, metav1.ListOptions{})
-		if tenant.IsAPINotAvailable(err) {
-			// During cluster creation / upgrade the tenant API is naturally not
-			// available but this resource must still continue execution as that's
-			// when `Creating` and `Upgrading` conditions may need to be applied.
-			r.logger.Debugf(ctx, "tenant API not available yet")
-		} else if err != nil {
-			return microerror.Mask(err)
-		} else {
-			nodes = l.Items
-
-			r.logger.Debugf(ctx, "found %d nodes from tenant cluster", len(nodes))
-		}
+	} else {
+		r.logger.Debugf(ctx, "found %d nodes from tenant cluster", len(nodes))
 	}
 
 	cpList := &infrastructurev1alpha3.G8sControlPlaneList{}
@@ -117,11 +111,76 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		r.logger.Debugf(ctx, "found %d MachineDeployments for tenant cluster", len(mdList.Items))
 	}
 
+	var degraded *apiv1alpha3.Condition
+	{
+		current := conditions.Get(&cl, condition.Degraded)
+		degraded = degradedCondition(current, cr.GetCommonClusterStatus(), nodes, cpList.Items, mdList.Items, r.degradedThreshold, time.Now())
+	}
+
+	var stuck *apiv1alpha3.Condition
+	{
+		timeouts, err := r.releaseVersion.TransitionTimeouts(ctx, cr)
+		if releaseversion.IsInvalidAnnotation(err) {
+			r.logger.LogCtx(ctx, "level", "warning", "message", "not computing stuck condition due to invalid transition timeouts", "stack", microerror.JSON(err))
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else {
+			desiredVersion, err := r.getDesiredVersion(ctx, cr)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			providerOperatorVersionLabel := fmt.Sprintf("%s-operator.giantswarm.io/version", r.provider)
+			blockers := transitionBlockers(conditions.Get(&cl, condition.AppsReady), nodes, cpList.Items, mdList.Items, desiredVersion, providerOperatorVersionLabel)
+			stuck = stuckCondition(cr.GetCommonClusterStatus(), timeouts, blockers, time.Now())
+		}
+	}
+
+	var tenantAPI *apiv1alpha3.Condition
+	{
+		b, err := r.tenantClient.Breaker(ctx, cr)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		tenantAPI = tenantAPIAvailableCondition(b)
+	}
+
+	hibernated := hibernatedCondition(conditions.Get(&cl, condition.Hibernated), key.IsHibernated(&cl), mdList.Items)
+
+	err = r.ensureClusterConditions(ctx, cl, degraded, stuck, tenantAPI, hibernated)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	err = r.computeClusterStatusConditions(ctx, cl, uc, nodes, cpList.Items, mdList.Items)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
+	err = r.compactStatusHistory(ctx, uc)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// During updates the progress of the rolling upgrade is emitted each time
+	// it changes.
+	if uc.GetCommonClusterStatus().LatestCondition() == infrastructurev1alpha3.ClusterStatusConditionUpdating {
+		desiredVersion, err := r.getDesiredVersion(ctx, uc)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		providerOperatorVersionLabel := fmt.Sprintf("%s-operator.giantswarm.io/version", r.provider)
+		progress := nodeUpgradeProgress(nodes, cpList.Items, mdList.Items, desiredVersion, providerOperatorVersionLabel)
+		message := upgradeProgressMessage(progress, r.provider, desiredVersion)
+
+		if r.upgradeProgressChanged(key.ClusterID(&cl), message) {
+			r.event.Emit(ctx, &cl, "ClusterUpgradeProgress", message)
+		}
+	} else {
+		r.upgradeProgressChanged(key.ClusterID(&cl), "")
+	}
+
 	if !reflect.DeepEqual(cr.GetCommonClusterStatus(), uc.GetCommonClusterStatus()) {
 		r.logger.Debugf(ctx, "updating cluster status")
 
@@ -0,0 +1,160 @@
+package statuscondition
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+// pressureConditions are the node conditions rendering a tenant cluster
+// degraded as soon as any node reports them.
+var pressureConditions = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodeNetworkUnavailable,
+}
+
+// degradedCondition computes the Degraded condition of a tenant cluster. The
+// condition is only evaluated once the tenant cluster is created or updated,
+// because replicas being below desired replicas is expected during
+// transitions. Nodes reporting pressure render the tenant cluster degraded
+// immediately. Ready replicas being below desired replicas render the tenant
+// cluster degraded once that lasted for longer than the given threshold. Until
+// then the condition is unknown, so that its last transition time tracks when
+// replicas were found not ready.
+func degradedCondition(current *apiv1alpha3.Condition, status infrastructurev1alpha3.CommonClusterStatus, nodes []corev1.Node, controlPlanes []infrastructurev1alpha3.G8sControlPlane, machineDeployments []apiv1alpha3.MachineDeployment, threshold time.Duration, now time.Time) *apiv1alpha3.Condition {
+	latest := status.LatestCondition()
+	if latest != infrastructurev1alpha3.ClusterStatusConditionCreated && latest != infrastructurev1alpha3.ClusterStatusConditionUpdated {
+		return conditions.FalseCondition(
+			condition.Degraded,
+			condition.ClusterTransitioningReason,
+			apiv1alpha3.ConditionSeverityInfo,
+			"cluster is in condition %s", latest,
+		)
+	}
+
+	notReady := replicasNotReady(controlPlanes, machineDeployments)
+	pressure := nodesUnderPressure(nodes)
+
+	if len(pressure) > 0 {
+		return &apiv1alpha3.Condition{
+			Type:    condition.Degraded,
+			Status:  corev1.ConditionTrue,
+			Reason:  condition.NodePressureReason,
+			Message: strings.Join(append(pressure, notReady...), "; "),
+		}
+	}
+
+	if len(notReady) > 0 {
+		// A tenant cluster already found degraded stays degraded as long as
+		// replicas are not ready. Only the last transition time of the unknown
+		// condition tracks for how long replicas were found not ready.
+		degraded := current != nil && current.Status == corev1.ConditionTrue
+
+		since := now
+		if current != nil && current.Status == corev1.ConditionUnknown {
+			since = current.LastTransitionTime.Time
+		}
+
+		if degraded || now.Sub(since) >= threshold {
+			return &apiv1alpha3.Condition{
+				Type:    condition.Degraded,
+				Status:  corev1.ConditionTrue,
+				Reason:  condition.ReplicasNotReadyReason,
+				Message: strings.Join(notReady, "; "),
+			}
+		}
+
+		return conditions.UnknownCondition(
+			condition.Degraded,
+			condition.ReplicasNotReadyReason,
+			"%s", strings.Join(notReady, "; "),
+		)
+	}
+
+	return &apiv1alpha3.Condition{
+		Type:   condition.Degraded,
+		Status: corev1.ConditionFalse,
+	}
+}
+
+func nodesUnderPressure(nodes []corev1.Node) []string {
+	var details []string
+
+	for _, n := range nodes {
+		var types []string
+		for _, c := range n.Status.Conditions {
+			for _, t := range pressureConditions {
+				if c.Type == t && c.Status == corev1.ConditionTrue {
+					types = append(types, string(t))
+				}
+			}
+		}
+
+		if len(types) == 0 {
+			continue
+		}
+
+		np := n.Labels[label.MachineDeployment]
+		if np == "" {
+			details = append(details, fmt.Sprintf("node %s reports %s", n.Name, strings.Join(types, ", ")))
+		} else {
+			details = append(details, fmt.Sprintf("node %s of node pool %s reports %s", n.Name, np, strings.Join(types, ", ")))
+		}
+	}
+
+	sort.Strings(details)
+
+	return details
+}
+
+func replicasNotReady(controlPlanes []infrastructurev1alpha3.G8sControlPlane, machineDeployments []apiv1alpha3.MachineDeployment) []string {
+	var details []string
+
+	if m := mastersNotReady(controlPlanes); m != "" {
+		details = append(details, m)
+	}
+
+	return append(details, workersNotReady(machineDeployments)...)
+}
+
+func mastersNotReady(controlPlanes []infrastructurev1alpha3.G8sControlPlane) string {
+	var desired int
+	var ready int
+	for _, cp := range controlPlanes {
+		desired += int(cp.Status.Replicas)
+		ready += int(cp.Status.ReadyReplicas)
+	}
+
+	if ready < desired {
+		return fmt.Sprintf("control plane has %d of %d masters ready", ready, desired)
+	}
+
+	return ""
+}
+
+func workersNotReady(machineDeployments []apiv1alpha3.MachineDeployment) []string {
+	var details []string
+
+	for _, md := range machineDeployments {
+		md := md // dereferencing pointer value into new scope
+
+		if md.Status.ReadyReplicas < md.Status.Replicas {
+			details = append(details, fmt.Sprintf("node pool %s has %d of %d workers ready", key.MachineDeployment(&md), md.Status.ReadyReplicas, md.Status.Replicas))
+		}
+	}
+
+	sort.Strings(details)
+
+	return details
+}
@@ -0,0 +1,224 @@
+package statuscondition
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+)
+
+func Test_degradedCondition(t *testing.T) {
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	created := infrastructurev1alpha3.CommonClusterStatus{
+		Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+			{
+				Condition: infrastructurev1alpha3.ClusterStatusConditionCreated,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		current            *apiv1alpha3.Condition
+		status             infrastructurev1alpha3.CommonClusterStatus
+		nodes              []corev1.Node
+		controlPlanes      []infrastructurev1alpha3.G8sControlPlane
+		machineDeployments []apiv1alpha3.MachineDeployment
+		expectedStatus     corev1.ConditionStatus
+		expectedReason     string
+		expectedMessage    string
+	}{
+		{
+			name: "case 0: cluster is creating",
+			status: infrastructurev1alpha3.CommonClusterStatus{
+				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+					{
+						Condition: infrastructurev1alpha3.ClusterStatusConditionCreating,
+					},
+				},
+			},
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 1),
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  condition.ClusterTransitioningReason,
+			expectedMessage: "cluster is in condition Creating",
+		},
+		{
+			name:   "case 1: cluster is healthy",
+			status: created,
+			nodes: []corev1.Node{
+				newNode("ip-10-1-0-1", "a1b2c"),
+			},
+			controlPlanes: []infrastructurev1alpha3.G8sControlPlane{
+				newControlPlane(1, 1),
+			},
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 3),
+			},
+			expectedStatus: corev1.ConditionFalse,
+		},
+		{
+			name:   "case 2: replicas not ready for the first time",
+			status: created,
+			controlPlanes: []infrastructurev1alpha3.G8sControlPlane{
+				newControlPlane(3, 2),
+			},
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("z9y8x", 3, 1),
+				newMachineDeployment("a1b2c", 3, 3),
+			},
+			expectedStatus:  corev1.ConditionUnknown,
+			expectedReason:  condition.ReplicasNotReadyReason,
+			expectedMessage: "control plane has 2 of 3 masters ready; node pool z9y8x has 1 of 3 workers ready",
+		},
+		{
+			name: "case 3: replicas not ready within threshold",
+			current: &apiv1alpha3.Condition{
+				Type:               condition.Degraded,
+				Status:             corev1.ConditionUnknown,
+				LastTransitionTime: metav1.NewTime(now.Add(-5 * time.Minute)),
+			},
+			status: created,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 2),
+			},
+			expectedStatus:  corev1.ConditionUnknown,
+			expectedReason:  condition.ReplicasNotReadyReason,
+			expectedMessage: "node pool a1b2c has 2 of 3 workers ready",
+		},
+		{
+			name: "case 4: replicas not ready beyond threshold",
+			current: &apiv1alpha3.Condition{
+				Type:               condition.Degraded,
+				Status:             corev1.ConditionUnknown,
+				LastTransitionTime: metav1.NewTime(now.Add(-15 * time.Minute)),
+			},
+			status: created,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 2),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  condition.ReplicasNotReadyReason,
+			expectedMessage: "node pool a1b2c has 2 of 3 workers ready",
+		},
+		{
+			name:   "case 5: nodes under pressure",
+			status: created,
+			nodes: []corev1.Node{
+				newNode("ip-10-1-0-2", "a1b2c", corev1.NodeMemoryPressure, corev1.NodeDiskPressure),
+				newNode("ip-10-1-0-1", "", corev1.NodeNetworkUnavailable),
+				newNode("ip-10-1-0-3", "a1b2c"),
+			},
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 3),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  condition.NodePressureReason,
+			expectedMessage: "node ip-10-1-0-1 reports NetworkUnavailable; node ip-10-1-0-2 of node pool a1b2c reports MemoryPressure, DiskPressure",
+		},
+		{
+			name: "case 6: replicas still not ready after being degraded",
+			current: &apiv1alpha3.Condition{
+				Type:               condition.Degraded,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(now.Add(-1 * time.Minute)),
+			},
+			status: created,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 2),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  condition.ReplicasNotReadyReason,
+			expectedMessage: "node pool a1b2c has 2 of 3 workers ready",
+		},
+		{
+			name: "case 7: replicas not ready after recovering",
+			current: &apiv1alpha3.Condition{
+				Type:               condition.Degraded,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(now.Add(-15 * time.Minute)),
+			},
+			status: created,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 2),
+			},
+			expectedStatus:  corev1.ConditionUnknown,
+			expectedReason:  condition.ReplicasNotReadyReason,
+			expectedMessage: "node pool a1b2c has 2 of 3 workers ready",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			c := degradedCondition(tc.current, tc.status, tc.nodes, tc.controlPlanes, tc.machineDeployments, 10*time.Minute, now)
+
+			if c.Type != condition.Degraded {
+				t.Fatalf("expected %#q to be equal to %#q", condition.Degraded, c.Type)
+			}
+			if c.Status != tc.expectedStatus {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedStatus, c.Status)
+			}
+			if c.Reason != tc.expectedReason {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedReason, c.Reason)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
+
+func newControlPlane(replicas, readyReplicas int32) infrastructurev1alpha3.G8sControlPlane {
+	return infrastructurev1alpha3.G8sControlPlane{
+		Status: infrastructurev1alpha3.G8sControlPlaneStatus{
+			Replicas:      replicas,
+			ReadyReplicas: readyReplicas,
+		},
+	}
+}
+
+func newMachineDeployment(id string, replicas, readyReplicas int32) apiv1alpha3.MachineDeployment {
+	return apiv1alpha3.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: id,
+			Labels: map[string]string{
+				label.MachineDeployment: id,
+			},
+		},
+		Status: apiv1alpha3.MachineDeploymentStatus{
+			Replicas:      replicas,
+			ReadyReplicas: readyReplicas,
+		},
+	}
+}
+
+func newNode(name string, nodePool string, pressure ...corev1.NodeConditionType) corev1.Node {
+	n := corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{},
+		},
+	}
+
+	if nodePool != "" {
+		n.Labels[label.MachineDeployment] = nodePool
+	}
+
+	for _, p := range pressure {
+		n.Status.Conditions = append(n.Status.Conditions, corev1.NodeCondition{
+			Type:   p,
+			Status: corev1.ConditionTrue,
+		})
+	}
+
+	return n
+}
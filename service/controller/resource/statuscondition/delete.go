@@ -15,13 +15,18 @@ import (
 )
 
 func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	cl, err := key.ToCluster(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = r.ensureDeletingCondition(ctx, cl)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
 	cr := r.newCommonClusterObjectFunc()
 	{
-		cl, err := key.ToCluster(obj)
-		if err != nil {
-			return microerror.Mask(err)
-		}
-
 		r.logger.Debugf(ctx, "finding latest infrastructure reference for cluster %#q", key.ClusterID(&cl))
 
 		err = r.k8sClient.CtrlClient().Get(ctx, key.ObjRefToNamespacedName(key.ObjRefFromCluster(cl)), cr)
@@ -0,0 +1,121 @@
+package statuscondition
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
+)
+
+// ensureDeletingCondition sets the Deleting condition on the given Cluster CR
+// describing the deletion step currently pending. An event is emitted each time
+// the pending step changes so that stuck deletions can be investigated.
+func (r *Resource) ensureDeletingCondition(ctx context.Context, obj apiv1alpha3.Cluster) error {
+	var cl apiv1alpha3.Cluster
+	{
+		r.logger.Debugf(ctx, "finding cluster")
+
+		err := r.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}, &cl)
+		if apierrors.IsNotFound(err) {
+			r.logger.Debugf(ctx, "did not find cluster")
+			return nil
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "found cluster")
+	}
+
+	p, err := r.deletionProgress.Progress(ctx, cl)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	updated := cl.DeepCopy()
+	{
+		conditions.Set(updated, deletingCondition(p))
+		conditions.MarkFalse(updated, condition.Ready, condition.DeletingReason, apiv1alpha3.ConditionSeverityInfo, "")
+	}
+
+	if !key.ConditionsEqual(cl.GetConditions(), updated.GetConditions()) {
+		r.logger.Debugf(ctx, "updating cluster conditions")
+
+		err := r.k8sClient.CtrlClient().Status().Update(ctx, updated)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated cluster conditions")
+
+		c := conditions.Get(updated, condition.Deleting)
+		if c.Reason != conditions.GetReason(&cl, condition.Deleting) {
+			r.event.Emit(ctx, &cl, c.Reason, c.Message)
+		}
+	}
+
+	return nil
+}
+
+// deletingCondition computes the Deleting condition from the given deletion
+// progress. The reason names the first pending deletion step and the message
+// lists all of them.
+func deletingCondition(p deletionprogress.Progress) *apiv1alpha3.Condition {
+	var reason string
+	var pending []string
+
+	setReason := func(r string) {
+		if reason == "" {
+			reason = r
+		}
+	}
+
+	if len(p.MachineDeployments) > 0 {
+		setReason(condition.WaitingForMachineDeploymentsReason)
+		pending = append(pending, fmt.Sprintf("%d MachineDeployments", len(p.MachineDeployments)))
+	}
+	if len(p.G8sControlPlanes) > 0 {
+		setReason(condition.WaitingForG8sControlPlanesReason)
+		pending = append(pending, fmt.Sprintf("%d G8sControlPlanes", len(p.G8sControlPlanes)))
+	}
+	if p.InfrastructureRef != "" {
+		setReason(condition.WaitingForInfrastructureReason)
+		pending = append(pending, p.InfrastructureRef)
+	}
+	if len(p.Apps) > 0 {
+		apps := append([]string{}, p.Apps...)
+		sort.Strings(apps)
+		setReason(condition.WaitingForAppsReason)
+		pending = append(pending, fmt.Sprintf("apps %s", strings.Join(apps, ", ")))
+	}
+	if p.Namespace != "" {
+		setReason(condition.WaitingForNamespaceReason)
+		pending = append(pending, fmt.Sprintf("namespace %s", p.Namespace))
+	}
+
+	if len(pending) == 0 {
+		return &apiv1alpha3.Condition{
+			Type:    condition.Deleting,
+			Status:  corev1.ConditionTrue,
+			Reason:  condition.DeletingReason,
+			Message: "removing finalizers",
+		}
+	}
+
+	return &apiv1alpha3.Condition{
+		Type:    condition.Deleting,
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: fmt.Sprintf("waiting for deletion of %s", strings.Join(pending, "; ")),
+	}
+}
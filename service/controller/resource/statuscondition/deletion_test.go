@@ -0,0 +1,79 @@
+package statuscondition
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
+)
+
+func Test_deletingCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		progress        deletionprogress.Progress
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "case 0: nothing pending",
+			progress:        deletionprogress.Progress{},
+			expectedReason:  condition.DeletingReason,
+			expectedMessage: "removing finalizers",
+		},
+		{
+			name: "case 1: everything pending",
+			progress: deletionprogress.Progress{
+				MachineDeployments: []string{"a1b2c", "d3e4f"},
+				G8sControlPlanes:   []string{"8y5ck"},
+				InfrastructureRef:  "AWSCluster 8y5ck",
+				Apps:               []string{"coredns", "app-operator-8y5ck"},
+				Namespace:          "8y5ck",
+			},
+			expectedReason:  condition.WaitingForMachineDeploymentsReason,
+			expectedMessage: "waiting for deletion of 2 MachineDeployments; 1 G8sControlPlanes; AWSCluster 8y5ck; apps app-operator-8y5ck, coredns; namespace 8y5ck",
+		},
+		{
+			name: "case 2: infrastructure reference pending",
+			progress: deletionprogress.Progress{
+				InfrastructureRef: "AWSCluster 8y5ck",
+				Namespace:         "8y5ck",
+			},
+			expectedReason:  condition.WaitingForInfrastructureReason,
+			expectedMessage: "waiting for deletion of AWSCluster 8y5ck; namespace 8y5ck",
+		},
+		{
+			name: "case 3: apps pending",
+			progress: deletionprogress.Progress{
+				Apps:      []string{"app-operator-8y5ck"},
+				Namespace: "8y5ck",
+			},
+			expectedReason:  condition.WaitingForAppsReason,
+			expectedMessage: "waiting for deletion of apps app-operator-8y5ck; namespace 8y5ck",
+		},
+		{
+			name: "case 4: namespace pending",
+			progress: deletionprogress.Progress{
+				Namespace: "8y5ck",
+			},
+			expectedReason:  condition.WaitingForNamespaceReason,
+			expectedMessage: "waiting for deletion of namespace 8y5ck",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			c := deletingCondition(tc.progress)
+
+			if c.Type != condition.Deleting {
+				t.Fatalf("expected %#q to be equal to %#q", condition.Deleting, c.Type)
+			}
+			if c.Reason != tc.expectedReason {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedReason, c.Reason)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package statuscondition
+
+import (
+	"strconv"
+
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+)
+
+// hibernatedCondition computes the Hibernated condition of a tenant cluster
+// from the hibernation annotation of its Cluster CR and the state of its node
+// pools. Nil is returned in case the condition must not be present, i.e. when
+// the tenant cluster is neither hibernated nor being restored.
+func hibernatedCondition(current *apiv1alpha3.Condition, hibernate bool, machineDeployments []apiv1alpha3.MachineDeployment) *apiv1alpha3.Condition {
+	if hibernate {
+		var workers int32
+		for _, md := range machineDeployments {
+			workers += md.Status.Replicas
+		}
+
+		if workers > 0 {
+			return conditions.FalseCondition(
+				condition.Hibernated,
+				condition.HibernatingReason,
+				apiv1alpha3.ConditionSeverityInfo,
+				"waiting for %d workers to be removed", workers,
+			)
+		}
+
+		return conditions.TrueCondition(condition.Hibernated)
+	}
+
+	if current == nil {
+		return nil
+	}
+
+	var desired int32
+	var ready int32
+	var restoring bool
+	for _, md := range machineDeployments {
+		// The node pool was not scaled back yet.
+		if _, ok := md.Annotations[annotation.HibernatedReplicas]; ok {
+			restoring = true
+		}
+		desired += desiredReplicas(md)
+		ready += md.Status.ReadyReplicas
+	}
+
+	if restoring || ready < desired {
+		return conditions.FalseCondition(
+			condition.Hibernated,
+			condition.RestoringReason,
+			apiv1alpha3.ConditionSeverityInfo,
+			"waiting for %d of %d workers to be ready", desired-ready, desired,
+		)
+	}
+
+	return nil
+}
+
+// desiredReplicas returns the number of workers the given node pool is restored
+// to. Node pools without replicas are managed by the cluster autoscaler, which
+// is why their restored autoscaler minimum size is used. The minimum size
+// recorded during hibernation is used in case it was not restored yet.
+func desiredReplicas(md apiv1alpha3.MachineDeployment) int32 {
+	if md.Spec.Replicas != nil {
+		return *md.Spec.Replicas
+	}
+
+	for _, a := range []string{annotation.AutoscalerMinSize, annotation.HibernatedMinSize} {
+		v, ok := md.Annotations[a]
+		if !ok {
+			continue
+		}
+
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0
+		}
+
+		return int32(n)
+	}
+
+	return 0
+}
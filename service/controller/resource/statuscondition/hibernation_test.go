@@ -0,0 +1,180 @@
+package statuscondition
+
+import (
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+)
+
+func Test_hibernatedCondition(t *testing.T) {
+	hibernatedMD := func(replicas int32, ready int32, annotations map[string]string) apiv1alpha3.MachineDeployment {
+		return apiv1alpha3.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: annotations,
+			},
+			Spec: apiv1alpha3.MachineDeploymentSpec{
+				Replicas: &replicas,
+			},
+			Status: apiv1alpha3.MachineDeploymentStatus{
+				Replicas:      ready,
+				ReadyReplicas: ready,
+			},
+		}
+	}
+
+	autoscaledMD := func(ready int32, annotations map[string]string) apiv1alpha3.MachineDeployment {
+		return apiv1alpha3.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: annotations,
+			},
+			Status: apiv1alpha3.MachineDeploymentStatus{
+				Replicas:      ready,
+				ReadyReplicas: ready,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		current            *apiv1alpha3.Condition
+		hibernate          bool
+		machineDeployments []apiv1alpha3.MachineDeployment
+		expectedNil        bool
+		expectedStatus     corev1.ConditionStatus
+		expectedReason     string
+		expectedMessage    string
+	}{
+		{
+			name:      "case 0: cluster not hibernated",
+			hibernate: false,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				hibernatedMD(3, 3, nil),
+			},
+			expectedNil: true,
+		},
+		{
+			name:      "case 1: node pools being scaled to zero",
+			hibernate: true,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				hibernatedMD(0, 2, map[string]string{annotation.HibernatedReplicas: "3"}),
+				hibernatedMD(0, 1, map[string]string{annotation.HibernatedReplicas: "1"}),
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  condition.HibernatingReason,
+			expectedMessage: "waiting for 3 workers to be removed",
+		},
+		{
+			name:      "case 2: all node pools scaled to zero",
+			hibernate: true,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				hibernatedMD(0, 0, map[string]string{annotation.HibernatedReplicas: "3"}),
+			},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "case 3: node pools not scaled back yet",
+			current: &apiv1alpha3.Condition{
+				Type:   condition.Hibernated,
+				Status: corev1.ConditionTrue,
+			},
+			hibernate: false,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				hibernatedMD(0, 0, map[string]string{annotation.HibernatedReplicas: "3"}),
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  condition.RestoringReason,
+			expectedMessage: "waiting for 0 of 0 workers to be ready",
+		},
+		{
+			name: "case 4: workers of restored node pools not ready yet",
+			current: &apiv1alpha3.Condition{
+				Type:   condition.Hibernated,
+				Status: corev1.ConditionFalse,
+				Reason: condition.RestoringReason,
+			},
+			hibernate: false,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				hibernatedMD(3, 1, nil),
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  condition.RestoringReason,
+			expectedMessage: "waiting for 2 of 3 workers to be ready",
+		},
+		{
+			name: "case 5: cluster restored",
+			current: &apiv1alpha3.Condition{
+				Type:   condition.Hibernated,
+				Status: corev1.ConditionFalse,
+				Reason: condition.RestoringReason,
+			},
+			hibernate: false,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				hibernatedMD(3, 3, nil),
+			},
+			expectedNil: true,
+		},
+		{
+			name: "case 6: workers of restored autoscaled node pools not ready yet",
+			current: &apiv1alpha3.Condition{
+				Type:   condition.Hibernated,
+				Status: corev1.ConditionFalse,
+				Reason: condition.RestoringReason,
+			},
+			hibernate: false,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				autoscaledMD(1, map[string]string{annotation.AutoscalerMaxSize: "10", annotation.AutoscalerMinSize: "3"}),
+				hibernatedMD(2, 2, nil),
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  condition.RestoringReason,
+			expectedMessage: "waiting for 2 of 5 workers to be ready",
+		},
+		{
+			name: "case 7: autoscaled node pools restored",
+			current: &apiv1alpha3.Condition{
+				Type:   condition.Hibernated,
+				Status: corev1.ConditionFalse,
+				Reason: condition.RestoringReason,
+			},
+			hibernate: false,
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				autoscaledMD(3, map[string]string{annotation.AutoscalerMaxSize: "10", annotation.AutoscalerMinSize: "3"}),
+			},
+			expectedNil: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c := hibernatedCondition(tc.current, tc.hibernate, tc.machineDeployments)
+
+			if tc.expectedNil {
+				if c != nil {
+					t.Fatalf("expected %#v to be equal to %#v", nil, c)
+				}
+				return
+			}
+
+			if c.Type != condition.Hibernated {
+				t.Fatalf("expected %#q to be equal to %#q", condition.Hibernated, c.Type)
+			}
+			if c.Status != tc.expectedStatus {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedStatus, c.Status)
+			}
+			if c.Reason != tc.expectedReason {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedReason, c.Reason)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
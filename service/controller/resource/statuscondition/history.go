@@ -0,0 +1,261 @@
+package statuscondition
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+const (
+	historyConditionsKey = "conditions"
+	historyVersionsKey   = "versions"
+)
+
+// compactStatusHistory bounds the status conditions and versions of the given
+// infrastructure cluster CR to the configured limit. Trimmed entries are
+// archived in a config map if configured, before they are removed from the
+// status.
+func (r *Resource) compactStatusHistory(ctx context.Context, cr infrastructurev1alpha3.CommonClusterObject) error {
+	status := cr.GetCommonClusterStatus()
+
+	conditions, trimmedConditions := compactConditions(status.Conditions, r.statusHistoryLimit)
+	versions, trimmedVersions := compactVersions(status.Versions, r.statusHistoryLimit)
+
+	if len(trimmedConditions) == 0 && len(trimmedVersions) == 0 {
+		return nil
+	}
+
+	if r.statusHistoryArchive {
+		err := r.archiveStatusHistory(ctx, cr, trimmedConditions, trimmedVersions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	r.logger.Debugf(ctx, "trimming %d status conditions and %d status versions", len(trimmedConditions), len(trimmedVersions))
+
+	status.Conditions = conditions
+	status.Versions = versions
+	cr.SetCommonClusterStatus(status)
+
+	return nil
+}
+
+func (r *Resource) archiveStatusHistory(ctx context.Context, cr infrastructurev1alpha3.CommonClusterObject, conditions []infrastructurev1alpha3.CommonClusterStatusCondition, versions []infrastructurev1alpha3.CommonClusterStatusVersion) error {
+	name := key.StatusHistoryConfigMapName(cr)
+	namespace := cr.GetNamespace()
+
+	r.logger.Debugf(ctx, "finding status history config map %#q in namespace %#q", name, namespace)
+
+	cm, err := r.k8sClient.K8sClient().CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		r.logger.Debugf(ctx, "did not find status history config map %#q in namespace %#q", name, namespace)
+
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Annotations: map[string]string{
+					annotation.Notes: fmt.Sprintf("DO NOT EDIT. Values managed by %s.", project.Name()),
+				},
+				Labels: map[string]string{
+					label.Cluster:      key.ClusterID(cr),
+					label.ManagedBy:    project.Name(),
+					label.Organization: key.OrganizationID(cr),
+				},
+			},
+		}
+	} else if err != nil {
+		return microerror.Mask(err)
+	} else {
+		r.logger.Debugf(ctx, "found status history config map %#q in namespace %#q", name, namespace)
+	}
+
+	var archivedConditions []infrastructurev1alpha3.CommonClusterStatusCondition
+	var archivedVersions []infrastructurev1alpha3.CommonClusterStatusVersion
+	{
+		err = yaml.Unmarshal([]byte(cm.Data[historyConditionsKey]), &archivedConditions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		err = yaml.Unmarshal([]byte(cm.Data[historyVersionsKey]), &archivedVersions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		archivedConditions = mergeConditions(archivedConditions, conditions)
+		archivedVersions = mergeVersions(archivedVersions, versions)
+	}
+
+	{
+		c, err := yaml.Marshal(archivedConditions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+		v, err := yaml.Marshal(archivedVersions)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		cm.Data = map[string]string{
+			historyConditionsKey: string(c),
+			historyVersionsKey:   string(v),
+		}
+	}
+
+	if cm.ResourceVersion == "" {
+		r.logger.Debugf(ctx, "creating status history config map %#q in namespace %#q", name, namespace)
+
+		_, err = r.k8sClient.K8sClient().CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "created status history config map %#q in namespace %#q", name, namespace)
+	} else {
+		r.logger.Debugf(ctx, "updating status history config map %#q in namespace %#q", name, namespace)
+
+		_, err = r.k8sClient.K8sClient().CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated status history config map %#q in namespace %#q", name, namespace)
+	}
+
+	return nil
+}
+
+// compactConditions keeps the latest limit conditions, but always keeps the
+// latest condition of each type, because the status condition transitions
+// depend on them. The kept and trimmed conditions are returned sorted with the
+// latest condition first.
+func compactConditions(conditions []infrastructurev1alpha3.CommonClusterStatusCondition, limit int) ([]infrastructurev1alpha3.CommonClusterStatusCondition, []infrastructurev1alpha3.CommonClusterStatusCondition) {
+	if len(conditions) <= limit {
+		return conditions, nil
+	}
+
+	sorted := make([]infrastructurev1alpha3.CommonClusterStatusCondition, len(conditions))
+	copy(sorted, conditions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LastTransitionTime.After(sorted[j].LastTransitionTime.Time)
+	})
+
+	keep := make([]bool, len(sorted))
+	var kept int
+	{
+		seen := map[string]bool{}
+		for i, c := range sorted {
+			if !seen[c.Condition] {
+				seen[c.Condition] = true
+				keep[i] = true
+				kept++
+			}
+		}
+
+		for i := range sorted {
+			if kept >= limit {
+				break
+			}
+			if !keep[i] {
+				keep[i] = true
+				kept++
+			}
+		}
+	}
+
+	var compacted []infrastructurev1alpha3.CommonClusterStatusCondition
+	var trimmed []infrastructurev1alpha3.CommonClusterStatusCondition
+	for i, c := range sorted {
+		if keep[i] {
+			compacted = append(compacted, c)
+		} else {
+			trimmed = append(trimmed, c)
+		}
+	}
+
+	return compacted, trimmed
+}
+
+// compactVersions keeps the latest limit versions. The kept and trimmed
+// versions are returned sorted with the latest version first.
+func compactVersions(versions []infrastructurev1alpha3.CommonClusterStatusVersion, limit int) ([]infrastructurev1alpha3.CommonClusterStatusVersion, []infrastructurev1alpha3.CommonClusterStatusVersion) {
+	if len(versions) <= limit {
+		return versions, nil
+	}
+
+	sorted := make([]infrastructurev1alpha3.CommonClusterStatusVersion, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LastTransitionTime.After(sorted[j].LastTransitionTime.Time)
+	})
+
+	return sorted[:limit], sorted[limit:]
+}
+
+// mergeConditions adds the given conditions to the archived ones, skipping
+// conditions already archived, e.g. because updating the infrastructure cluster
+// CR failed after archiving. The result is sorted with the latest condition
+// first.
+func mergeConditions(archived []infrastructurev1alpha3.CommonClusterStatusCondition, conditions []infrastructurev1alpha3.CommonClusterStatusCondition) []infrastructurev1alpha3.CommonClusterStatusCondition {
+	merged := append([]infrastructurev1alpha3.CommonClusterStatusCondition{}, archived...)
+
+	for _, c := range conditions {
+		var found bool
+		for _, a := range archived {
+			if a.Condition == c.Condition && a.LastTransitionTime.Equal(&c.LastTransitionTime) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			merged = append(merged, c)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].LastTransitionTime.After(merged[j].LastTransitionTime.Time)
+	})
+
+	return merged
+}
+
+// mergeVersions adds the given versions to the archived ones, skipping
+// versions already archived. The result is sorted with the latest version
+// first.
+func mergeVersions(archived []infrastructurev1alpha3.CommonClusterStatusVersion, versions []infrastructurev1alpha3.CommonClusterStatusVersion) []infrastructurev1alpha3.CommonClusterStatusVersion {
+	merged := append([]infrastructurev1alpha3.CommonClusterStatusVersion{}, archived...)
+
+	for _, v := range versions {
+		var found bool
+		for _, a := range archived {
+			if a.Version == v.Version && a.LastTransitionTime.Equal(&v.LastTransitionTime) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			merged = append(merged, v)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].LastTransitionTime.After(merged[j].LastTransitionTime.Time)
+	})
+
+	return merged
+}
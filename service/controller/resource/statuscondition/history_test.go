@@ -0,0 +1,218 @@
+package statuscondition
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/micrologger/microloggertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
+)
+
+func Test_compactConditions(t *testing.T) {
+	testCases := []struct {
+		name               string
+		conditions         []infrastructurev1alpha3.CommonClusterStatusCondition
+		limit              int
+		expectedConditions []string
+		expectedTrimmed    []string
+	}{
+		{
+			name: "case 0: conditions within limit",
+			conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionCreated, 1),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionCreating, 2),
+			},
+			limit: 2,
+			expectedConditions: []string{
+				infrastructurev1alpha3.ClusterStatusConditionCreated,
+				infrastructurev1alpha3.ClusterStatusConditionCreating,
+			},
+		},
+		{
+			name: "case 1: older updates trimmed",
+			conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdated, 1),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdating, 2),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdated, 3),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdating, 4),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionCreated, 5),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionCreating, 6),
+			},
+			limit: 5,
+			expectedConditions: []string{
+				infrastructurev1alpha3.ClusterStatusConditionUpdated,
+				infrastructurev1alpha3.ClusterStatusConditionUpdating,
+				infrastructurev1alpha3.ClusterStatusConditionUpdated,
+				infrastructurev1alpha3.ClusterStatusConditionCreated,
+				infrastructurev1alpha3.ClusterStatusConditionCreating,
+			},
+			expectedTrimmed: []string{
+				infrastructurev1alpha3.ClusterStatusConditionUpdating,
+			},
+		},
+		{
+			name: "case 2: latest condition of each type kept beyond limit",
+			conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionCreating, 6),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdated, 1),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdating, 2),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdated, 3),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdating, 4),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionCreated, 5),
+			},
+			limit: 2,
+			expectedConditions: []string{
+				infrastructurev1alpha3.ClusterStatusConditionUpdated,
+				infrastructurev1alpha3.ClusterStatusConditionUpdating,
+				infrastructurev1alpha3.ClusterStatusConditionCreated,
+				infrastructurev1alpha3.ClusterStatusConditionCreating,
+			},
+			expectedTrimmed: []string{
+				infrastructurev1alpha3.ClusterStatusConditionUpdated,
+				infrastructurev1alpha3.ClusterStatusConditionUpdating,
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			conditions, trimmed := compactConditions(tc.conditions, tc.limit)
+
+			var c []string
+			for _, x := range conditions {
+				c = append(c, x.Condition)
+			}
+			var tr []string
+			for _, x := range trimmed {
+				tr = append(tr, x.Condition)
+			}
+
+			if !reflect.DeepEqual(c, tc.expectedConditions) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedConditions, c)
+			}
+			if !reflect.DeepEqual(tr, tc.expectedTrimmed) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedTrimmed, tr)
+			}
+		})
+	}
+}
+
+func Test_compactStatusHistory(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		archive                  bool
+		expectedConditions       int
+		expectedVersions         []string
+		expectedArchivedVersions []string
+	}{
+		{
+			name:               "case 0: trimmed history is dropped",
+			archive:            false,
+			expectedConditions: 4,
+			expectedVersions:   []string{"9.0.2", "9.0.1", "9.0.0"},
+		},
+		{
+			name:                     "case 1: trimmed history is archived",
+			archive:                  true,
+			expectedConditions:       4,
+			expectedVersions:         []string{"9.0.2", "9.0.1", "9.0.0"},
+			expectedArchivedVersions: []string{"8.7.1", "8.7.0"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			ctx := context.Background()
+
+			cl := unittest.DefaultCluster()
+			cl.Status.Cluster.Conditions = []infrastructurev1alpha3.CommonClusterStatusCondition{
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdated, 1),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionUpdating, 2),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionCreated, 3),
+				newStatusCondition(infrastructurev1alpha3.ClusterStatusConditionCreating, 4),
+			}
+			cl.Status.Cluster.Versions = []infrastructurev1alpha3.CommonClusterStatusVersion{
+				newStatusVersion("9.0.2", 1),
+				newStatusVersion("9.0.1", 2),
+				newStatusVersion("9.0.0", 3),
+				newStatusVersion("8.7.1", 4),
+				newStatusVersion("8.7.0", 5),
+			}
+
+			k8sClient := unittest.FakeK8sClient()
+
+			r := Resource{
+				k8sClient: k8sClient,
+				logger:    microloggertest.New(),
+
+				statusHistoryArchive: tc.archive,
+				statusHistoryLimit:   3,
+			}
+
+			err := r.compactStatusHistory(ctx, &cl)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			status := cl.GetCommonClusterStatus()
+
+			if len(status.Conditions) != tc.expectedConditions {
+				t.Fatalf("expected %d to be equal to %d", tc.expectedConditions, len(status.Conditions))
+			}
+
+			var versions []string
+			for _, v := range status.Versions {
+				versions = append(versions, v.Version)
+			}
+			if !reflect.DeepEqual(versions, tc.expectedVersions) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedVersions, versions)
+			}
+
+			cm, err := k8sClient.K8sClient().CoreV1().ConfigMaps(cl.Namespace).Get(ctx, key.StatusHistoryConfigMapName(&cl), metav1.GetOptions{})
+			if !tc.archive {
+				if err == nil {
+					t.Fatalf("expected status history config map to not exist")
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			var archived []infrastructurev1alpha3.CommonClusterStatusVersion
+			err = yaml.Unmarshal([]byte(cm.Data[historyVersionsKey]), &archived)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var archivedVersions []string
+			for _, v := range archived {
+				archivedVersions = append(archivedVersions, v.Version)
+			}
+			if !reflect.DeepEqual(archivedVersions, tc.expectedArchivedVersions) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedArchivedVersions, archivedVersions)
+			}
+		})
+	}
+}
+
+func newStatusCondition(condition string, hoursAgo time.Duration) infrastructurev1alpha3.CommonClusterStatusCondition {
+	return infrastructurev1alpha3.CommonClusterStatusCondition{
+		LastTransitionTime: metav1.NewTime(time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC).Add(-hoursAgo * time.Hour)),
+		Condition:          condition,
+	}
+}
+
+func newStatusVersion(version string, hoursAgo time.Duration) infrastructurev1alpha3.CommonClusterStatusVersion {
+	return infrastructurev1alpha3.CommonClusterStatusVersion{
+		LastTransitionTime: metav1.NewTime(time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC).Add(-hoursAgo * time.Hour)),
+		Version:            version,
+	}
+}
@@ -1,14 +1,19 @@
 package statuscondition
 
 import (
+	"sync"
+	"time"
+
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantinformer"
 )
 
 const (
@@ -16,28 +21,53 @@ const (
 )
 
 type Config struct {
-	Event          recorder.Interface
-	K8sClient      k8sclient.Interface
-	Logger         micrologger.Logger
-	ReleaseVersion releaseversion.Interface
-	TenantClient   tenantclient.Interface
+	DeletionProgress deletionprogress.Interface
+	Event            recorder.Interface
+	K8sClient        k8sclient.Interface
+	Logger           micrologger.Logger
+	ReleaseVersion   releaseversion.Interface
+	TenantClient     tenantclient.Interface
+	TenantInformer   tenantinformer.Interface
 
+	// DegradedThreshold is the duration ready replicas may stay below desired
+	// replicas before the tenant cluster is considered degraded.
+	DegradedThreshold          time.Duration
 	NewCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
 	Provider                   string
+	// StatusHistoryArchive defines whether status conditions and versions
+	// trimmed from the infrastructure cluster CR are archived in a config map.
+	StatusHistoryArchive bool
+	// StatusHistoryLimit is the number of status conditions and versions kept
+	// in the status of the infrastructure cluster CR. The latest condition of
+	// each type is always kept.
+	StatusHistoryLimit int
 }
 
 type Resource struct {
-	event          recorder.Interface
-	k8sClient      k8sclient.Interface
-	logger         micrologger.Logger
-	releaseVersion releaseversion.Interface
-	tenantClient   tenantclient.Interface
+	deletionProgress deletionprogress.Interface
+	event            recorder.Interface
+	k8sClient        k8sclient.Interface
+	logger           micrologger.Logger
+	releaseVersion   releaseversion.Interface
+	tenantClient     tenantclient.Interface
+	tenantInformer   tenantinformer.Interface
 
+	degradedThreshold          time.Duration
 	newCommonClusterObjectFunc func() infrastructurev1alpha3.CommonClusterObject
 	provider                   string
+	statusHistoryArchive       bool
+	statusHistoryLimit         int
+
+	mutex sync.Mutex
+	// upgradeProgress holds the upgrade progress last emitted per cluster ID
+	// of tenant clusters being updated.
+	upgradeProgress map[string]string
 }
 
 func New(config Config) (*Resource, error) {
+	if config.DeletionProgress == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.DeletionProgress must not be empty", config)
+	}
 	if config.Event == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Event must not be empty", config)
 	}
@@ -53,23 +83,39 @@ func New(config Config) (*Resource, error) {
 	if config.TenantClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.TenantClient must not be empty", config)
 	}
+	if config.TenantInformer == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TenantInformer must not be empty", config)
+	}
 
+	if config.DegradedThreshold < 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.DegradedThreshold must not be negative", config)
+	}
 	if config.NewCommonClusterObjectFunc == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.NewCommonClusterObjectFunc must not be empty", config)
 	}
 	if config.Provider == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Provider must not be empty", config)
 	}
+	if config.StatusHistoryLimit <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.StatusHistoryLimit must be greater than zero", config)
+	}
 
 	r := &Resource{
-		event:          config.Event,
-		k8sClient:      config.K8sClient,
-		logger:         config.Logger,
-		releaseVersion: config.ReleaseVersion,
-		tenantClient:   config.TenantClient,
+		deletionProgress: config.DeletionProgress,
+		event:            config.Event,
+		k8sClient:        config.K8sClient,
+		logger:           config.Logger,
+		releaseVersion:   config.ReleaseVersion,
+		tenantClient:     config.TenantClient,
+		tenantInformer:   config.TenantInformer,
 
+		degradedThreshold:          config.DegradedThreshold,
 		newCommonClusterObjectFunc: config.NewCommonClusterObjectFunc,
 		provider:                   config.Provider,
+		statusHistoryArchive:       config.StatusHistoryArchive,
+		statusHistoryLimit:         config.StatusHistoryLimit,
+
+		upgradeProgress: map[string]string{},
 	}
 
 	return r, nil
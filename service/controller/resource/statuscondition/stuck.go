@@ -0,0 +1,112 @@
+package statuscondition
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
+)
+
+// transitionBlocker describes why the creation or update of a tenant cluster
+// did not finish yet.
+type transitionBlocker struct {
+	Reason  string
+	Message string
+}
+
+// transitionBlockers computes everything blocking the creation or update of a
+// tenant cluster. These are the same checks gating the Created and Updated
+// status conditions, plus the apps of the tenant cluster as reflected by the
+// AppsReady condition.
+func transitionBlockers(appsReady *apiv1alpha3.Condition, nodes []corev1.Node, controlPlanes []infrastructurev1alpha3.G8sControlPlane, machineDeployments []apiv1alpha3.MachineDeployment, desiredVersion string, providerOperatorVersionLabel string) []transitionBlocker {
+	var blockers []transitionBlocker
+
+	if m := mastersNotReady(controlPlanes); m != "" {
+		blockers = append(blockers, transitionBlocker{Reason: condition.MastersNotReadyReason, Message: m})
+	}
+
+	for _, m := range workersNotReady(machineDeployments) {
+		blockers = append(blockers, transitionBlocker{Reason: condition.WorkersNotReadyReason, Message: m})
+	}
+
+	if !allNodesHaveVersion(nodes, desiredVersion, providerOperatorVersionLabel) {
+		var mismatched int
+		for _, n := range nodes {
+			if n.Labels[providerOperatorVersionLabel] != desiredVersion {
+				mismatched++
+			}
+		}
+
+		var m string
+		if len(nodes) == 0 {
+			m = "no nodes found to check for version " + desiredVersion
+		} else {
+			m = fmt.Sprintf("%d of %d nodes do not have version %s", mismatched, len(nodes), desiredVersion)
+		}
+
+		blockers = append(blockers, transitionBlocker{Reason: condition.NodeVersionsMismatchedReason, Message: m})
+	}
+
+	if appsReady != nil && appsReady.Status == corev1.ConditionFalse {
+		blockers = append(blockers, transitionBlocker{Reason: condition.AppsFailingReason, Message: appsReady.Message})
+	}
+
+	return blockers
+}
+
+// stuckCondition computes the Stuck condition of a tenant cluster. The tenant
+// cluster is stuck when it is in the Creating or Updating status condition for
+// longer than the according timeout. The reason of the condition is the first
+// of the given blockers and the message lists all of them.
+func stuckCondition(status infrastructurev1alpha3.CommonClusterStatus, timeouts releaseversion.TransitionTimeouts, blockers []transitionBlocker, now time.Time) *apiv1alpha3.Condition {
+	notStuck := &apiv1alpha3.Condition{
+		Type:   condition.Stuck,
+		Status: corev1.ConditionFalse,
+	}
+
+	var transition string
+	var since time.Time
+	var timeout time.Duration
+	switch status.LatestCondition() {
+	case infrastructurev1alpha3.ClusterStatusConditionCreating:
+		transition = "creation"
+		since = status.GetCreatingCondition().LastTransitionTime.Time
+		timeout = timeouts.Creation
+	case infrastructurev1alpha3.ClusterStatusConditionUpdating:
+		transition = "update"
+		since = status.GetUpdatingCondition().LastTransitionTime.Time
+		timeout = timeouts.Update
+	default:
+		return notStuck
+	}
+
+	if now.Sub(since) < timeout {
+		return notStuck
+	}
+
+	reason := condition.TransitionTimeoutExceededReason
+	message := fmt.Sprintf("cluster %s exceeded timeout of %s", transition, timeout)
+
+	if len(blockers) > 0 {
+		var messages []string
+		for _, b := range blockers {
+			messages = append(messages, b.Message)
+		}
+
+		reason = blockers[0].Reason
+		message = fmt.Sprintf("%s: %s", message, strings.Join(messages, "; "))
+	}
+
+	return &apiv1alpha3.Condition{
+		Type:    condition.Stuck,
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	}
+}
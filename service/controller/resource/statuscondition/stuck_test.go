@@ -0,0 +1,156 @@
+package statuscondition
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
+)
+
+func Test_stuckCondition(t *testing.T) {
+	timeouts := releaseversion.TransitionTimeouts{
+		Creation: 30 * time.Minute,
+		Update:   2 * time.Hour,
+	}
+
+	versionLabel := "aws-operator.giantswarm.io/version"
+
+	testCases := []struct {
+		name               string
+		status             infrastructurev1alpha3.CommonClusterStatus
+		appsReady          *apiv1alpha3.Condition
+		nodes              []corev1.Node
+		controlPlanes      []infrastructurev1alpha3.G8sControlPlane
+		machineDeployments []apiv1alpha3.MachineDeployment
+		expectedStatus     corev1.ConditionStatus
+		expectedReason     string
+		expectedMessage    string
+	}{
+		{
+			name: "case 0: creation within timeout",
+			status: infrastructurev1alpha3.CommonClusterStatus{
+				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+					unittest.GetCreatingCondition(10),
+				},
+			},
+			controlPlanes: []infrastructurev1alpha3.G8sControlPlane{
+				newControlPlane(1, 0),
+			},
+			expectedStatus: corev1.ConditionFalse,
+		},
+		{
+			name: "case 1: creation beyond timeout with masters not ready",
+			status: infrastructurev1alpha3.CommonClusterStatus{
+				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+					unittest.GetCreatingCondition(40),
+				},
+			},
+			nodes: []corev1.Node{
+				newVersionNode(versionLabel, "9.0.0"),
+			},
+			controlPlanes: []infrastructurev1alpha3.G8sControlPlane{
+				newControlPlane(1, 0),
+			},
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 1),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  condition.MastersNotReadyReason,
+			expectedMessage: "cluster creation exceeded timeout of 30m0s: control plane has 0 of 1 masters ready; node pool a1b2c has 1 of 3 workers ready",
+		},
+		{
+			name: "case 2: update within timeout",
+			status: infrastructurev1alpha3.CommonClusterStatus{
+				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+					unittest.GetUpdatingCondition(60),
+					unittest.GetCreatedCondition(600),
+				},
+			},
+			nodes: []corev1.Node{
+				newVersionNode(versionLabel, "8.7.0"),
+			},
+			expectedStatus: corev1.ConditionFalse,
+		},
+		{
+			name: "case 3: update beyond timeout with node versions mismatched and apps failing",
+			status: infrastructurev1alpha3.CommonClusterStatus{
+				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+					unittest.GetUpdatingCondition(180),
+					unittest.GetCreatedCondition(600),
+				},
+			},
+			appsReady: &apiv1alpha3.Condition{
+				Type:    condition.AppsReady,
+				Status:  corev1.ConditionFalse,
+				Message: "apps not deployed: coredns",
+			},
+			nodes: []corev1.Node{
+				newVersionNode(versionLabel, "9.0.0"),
+				newVersionNode(versionLabel, "8.7.0"),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  condition.NodeVersionsMismatchedReason,
+			expectedMessage: "cluster update exceeded timeout of 2h0m0s: 1 of 2 nodes do not have version 9.0.0; apps not deployed: coredns",
+		},
+		{
+			name: "case 4: update beyond timeout without blockers",
+			status: infrastructurev1alpha3.CommonClusterStatus{
+				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+					unittest.GetUpdatingCondition(180),
+					unittest.GetCreatedCondition(600),
+				},
+			},
+			nodes: []corev1.Node{
+				newVersionNode(versionLabel, "9.0.0"),
+			},
+			expectedStatus:  corev1.ConditionTrue,
+			expectedReason:  condition.TransitionTimeoutExceededReason,
+			expectedMessage: "cluster update exceeded timeout of 2h0m0s",
+		},
+		{
+			name: "case 5: cluster updated",
+			status: infrastructurev1alpha3.CommonClusterStatus{
+				Conditions: []infrastructurev1alpha3.CommonClusterStatusCondition{
+					unittest.GetUpdatedCondition(5),
+					unittest.GetUpdatingCondition(180),
+					unittest.GetCreatedCondition(600),
+				},
+			},
+			expectedStatus: corev1.ConditionFalse,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			blockers := transitionBlockers(tc.appsReady, tc.nodes, tc.controlPlanes, tc.machineDeployments, "9.0.0", versionLabel)
+			c := stuckCondition(tc.status, timeouts, blockers, time.Now())
+
+			if c.Type != condition.Stuck {
+				t.Fatalf("expected %#q to be equal to %#q", condition.Stuck, c.Type)
+			}
+			if c.Status != tc.expectedStatus {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedStatus, c.Status)
+			}
+			if c.Reason != tc.expectedReason {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedReason, c.Reason)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
+
+func newVersionNode(versionLabel string, version string) corev1.Node {
+	n := newNode("", "")
+	n.Labels[versionLabel] = version
+
+	return n
+}
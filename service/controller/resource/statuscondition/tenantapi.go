@@ -0,0 +1,36 @@
+package statuscondition
+
+import (
+	"time"
+
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+)
+
+// tenantAPIAvailableCondition computes the TenantAPIAvailable condition of a
+// tenant cluster from the status of its circuit breaker. While the breaker is
+// not closed, requests against the tenant API are short-circuited, e.g. nodes
+// of the tenant cluster are not known.
+func tenantAPIAvailableCondition(b tenantclient.BreakerStatus) *apiv1alpha3.Condition {
+	switch b.State {
+	case tenantclient.BreakerHalfOpen:
+		return conditions.FalseCondition(
+			condition.TenantAPIAvailable,
+			condition.CircuitBreakerHalfOpenReason,
+			apiv1alpha3.ConditionSeverityInfo,
+			"probing tenant API after %d consecutive failures", b.Failures,
+		)
+	case tenantclient.BreakerOpen:
+		return conditions.FalseCondition(
+			condition.TenantAPIAvailable,
+			condition.CircuitBreakerOpenReason,
+			apiv1alpha3.ConditionSeverityWarning,
+			"tenant API unreachable after %d consecutive failures since %s", b.Failures, b.Since.UTC().Format(time.RFC3339),
+		)
+	}
+
+	return conditions.TrueCondition(condition.TenantAPIAvailable)
+}
@@ -0,0 +1,81 @@
+package statuscondition
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/condition"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+)
+
+func Test_tenantAPIAvailableCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		breaker         tenantclient.BreakerStatus
+		expectedStatus  corev1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name: "case 0: breaker is closed",
+			breaker: tenantclient.BreakerStatus{
+				State: tenantclient.BreakerClosed,
+			},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "case 1: breaker is closed with failures below threshold",
+			breaker: tenantclient.BreakerStatus{
+				Failures: 2,
+				State:    tenantclient.BreakerClosed,
+			},
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "case 2: breaker is open",
+			breaker: tenantclient.BreakerStatus{
+				Failures: 3,
+				Since:    time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC),
+				State:    tenantclient.BreakerOpen,
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  condition.CircuitBreakerOpenReason,
+			expectedMessage: "tenant API unreachable after 3 consecutive failures since 2021-09-01T12:00:00Z",
+		},
+		{
+			name: "case 3: breaker is half-open",
+			breaker: tenantclient.BreakerStatus{
+				Failures: 4,
+				Since:    time.Date(2021, 9, 1, 12, 2, 0, 0, time.UTC),
+				State:    tenantclient.BreakerHalfOpen,
+			},
+			expectedStatus:  corev1.ConditionFalse,
+			expectedReason:  condition.CircuitBreakerHalfOpenReason,
+			expectedMessage: "probing tenant API after 4 consecutive failures",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c := tenantAPIAvailableCondition(tc.breaker)
+
+			if c.Type != condition.TenantAPIAvailable {
+				t.Fatalf("expected %#q to be equal to %#q", condition.TenantAPIAvailable, c.Type)
+			}
+			if c.Status != tc.expectedStatus {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedStatus, c.Status)
+			}
+			if c.Reason != tc.expectedReason {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedReason, c.Reason)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, c.Message)
+			}
+		})
+	}
+}
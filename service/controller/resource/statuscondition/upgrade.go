@@ -0,0 +1,111 @@
+package statuscondition
+
+import (
+	"fmt"
+	"strings"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+// upgradeProgress describes how many nodes of a control plane or a node pool
+// run the desired provider operator version.
+type upgradeProgress struct {
+	Name     string
+	Upgraded int
+	Nodes    int
+}
+
+// nodeUpgradeProgress computes the upgrade progress of every control plane and
+// node pool of the tenant cluster based on the provider operator version label
+// of the given nodes. Control planes come first, followed by node pools, each
+// in the order they are given.
+func nodeUpgradeProgress(nodes []corev1.Node, controlPlanes []infrastructurev1alpha3.G8sControlPlane, machineDeployments []apiv1alpha3.MachineDeployment, version string, providerOperatorVersionLabel string) []upgradeProgress {
+	count := func(l string, id string) (int, int) {
+		var upgraded int
+		var total int
+		for _, n := range nodes {
+			if n.Labels[l] != id {
+				continue
+			}
+			total++
+			if n.Labels[providerOperatorVersionLabel] == version {
+				upgraded++
+			}
+		}
+
+		return upgraded, total
+	}
+
+	var progress []upgradeProgress
+
+	for _, cp := range controlPlanes {
+		id := cp.Labels[label.ControlPlane]
+		upgraded, total := count(label.ControlPlane, id)
+		progress = append(progress, upgradeProgress{
+			Name:     fmt.Sprintf("control plane %s", id),
+			Upgraded: upgraded,
+			Nodes:    total,
+		})
+	}
+
+	for _, md := range machineDeployments {
+		md := md // dereferencing pointer value into new scope
+
+		id := key.MachineDeployment(&md)
+		upgraded, total := count(label.MachineDeployment, id)
+		progress = append(progress, upgradeProgress{
+			Name:     fmt.Sprintf("node pool %s", id),
+			Upgraded: upgraded,
+			Nodes:    total,
+		})
+	}
+
+	return progress
+}
+
+// upgradeProgressMessage renders the given upgrade progress as event message,
+// e.g. 3 of 6 nodes run aws-operator version 9.0.1: control plane 8y5ck 1/1,
+// node pool a1b2c 2/5.
+func upgradeProgressMessage(progress []upgradeProgress, provider string, version string) string {
+	var upgraded int
+	var total int
+	var details []string
+	for _, p := range progress {
+		upgraded += p.Upgraded
+		total += p.Nodes
+		details = append(details, fmt.Sprintf("%s %d/%d", p.Name, p.Upgraded, p.Nodes))
+	}
+
+	msg := fmt.Sprintf("%d of %d nodes run %s-operator version %s", upgraded, total, provider, version)
+	if len(details) > 0 {
+		msg += ": " + strings.Join(details, ", ")
+	}
+
+	return msg
+}
+
+// upgradeProgressChanged records the given upgrade progress message of the
+// given tenant cluster and returns true when it differs from the one recorded
+// before. The empty message resets the recorded progress once the update is
+// done.
+func (r *Resource) upgradeProgressChanged(clusterID string, message string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.upgradeProgress[clusterID] == message {
+		return false
+	}
+
+	if message == "" {
+		delete(r.upgradeProgress, clusterID)
+	} else {
+		r.upgradeProgress[clusterID] = message
+	}
+
+	return true
+}
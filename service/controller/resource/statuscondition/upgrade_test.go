@@ -0,0 +1,87 @@
+package statuscondition
+
+import (
+	"strconv"
+	"testing"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+)
+
+func Test_upgradeProgressMessage(t *testing.T) {
+	versionLabel := "aws-operator.giantswarm.io/version"
+
+	controlPlane := newControlPlane(1, 1)
+	controlPlane.Labels = map[string]string{
+		label.ControlPlane: "8y5ck",
+	}
+
+	master := newVersionNode(versionLabel, "9.0.1")
+	master.Labels[label.ControlPlane] = "8y5ck"
+
+	testCases := []struct {
+		name               string
+		nodes              []corev1.Node
+		controlPlanes      []infrastructurev1alpha3.G8sControlPlane
+		machineDeployments []apiv1alpha3.MachineDeployment
+		expectedMessage    string
+	}{
+		{
+			name:            "case 0: no control planes and node pools",
+			expectedMessage: "0 of 0 nodes run aws-operator version 9.0.1",
+		},
+		{
+			name: "case 1: upgrade in progress",
+			nodes: []corev1.Node{
+				master,
+				newUpgradeNode(versionLabel, "a1b2c", "9.0.1"),
+				newUpgradeNode(versionLabel, "a1b2c", "9.0.0"),
+				newUpgradeNode(versionLabel, "a1b2c", "9.0.0"),
+				newUpgradeNode(versionLabel, "z9y8x", "9.0.0"),
+			},
+			controlPlanes: []infrastructurev1alpha3.G8sControlPlane{
+				controlPlane,
+			},
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 3, 3),
+				newMachineDeployment("z9y8x", 1, 1),
+			},
+			expectedMessage: "2 of 5 nodes run aws-operator version 9.0.1: control plane 8y5ck 1/1, node pool a1b2c 1/3, node pool z9y8x 0/1",
+		},
+		{
+			name: "case 2: upgrade finished",
+			nodes: []corev1.Node{
+				master,
+				newUpgradeNode(versionLabel, "a1b2c", "9.0.1"),
+			},
+			controlPlanes: []infrastructurev1alpha3.G8sControlPlane{
+				controlPlane,
+			},
+			machineDeployments: []apiv1alpha3.MachineDeployment{
+				newMachineDeployment("a1b2c", 1, 1),
+			},
+			expectedMessage: "2 of 2 nodes run aws-operator version 9.0.1: control plane 8y5ck 1/1, node pool a1b2c 1/1",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			progress := nodeUpgradeProgress(tc.nodes, tc.controlPlanes, tc.machineDeployments, "9.0.1", versionLabel)
+			msg := upgradeProgressMessage(progress, "aws", "9.0.1")
+
+			if msg != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, msg)
+			}
+		})
+	}
+}
+
+func newUpgradeNode(versionLabel string, nodePool string, version string) corev1.Node {
+	n := newNode("", nodePool)
+	n.Labels[versionLabel] = version
+
+	return n
+}
@@ -65,6 +65,26 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 			}
 		}
 
+		// Scaling the node pool to zero while the tenant cluster is
+		// hibernated and back to its recorded size afterwards.
+		if key.IsHibernated(&cr) {
+			if hibernate(&md) {
+				updated = true
+
+				r.logger.Debugf(ctx, "hibernating machine deployment %#q", md.Namespace+"/"+md.Name)
+			}
+		} else {
+			restored, err := restore(&md)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			if restored {
+				updated = true
+
+				r.logger.Debugf(ctx, "restoring machine deployment %#q", md.Namespace+"/"+md.Name)
+			}
+		}
+
 		if updated {
 			r.logger.Debugf(ctx, "updating machine deployment %#q for tenant cluster %#q", md.Namespace+"/"+md.Name, key.ClusterID(&cr))
 
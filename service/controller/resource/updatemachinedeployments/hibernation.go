@@ -0,0 +1,99 @@
+package updatemachinedeployments
+
+import (
+	"strconv"
+
+	"github.com/giantswarm/microerror"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+)
+
+// hibernate records the replicas and autoscaler bounds of the given
+// MachineDeployment CR in annotations and scales the node pool to zero. The
+// recorded values are not overwritten in case the node pool is already
+// hibernated, so that they survive multiple reconciliations. It returns true
+// in case the given CR was changed.
+func hibernate(md *apiv1alpha3.MachineDeployment) bool {
+	var updated bool
+
+	if md.Annotations == nil {
+		md.Annotations = map[string]string{}
+	}
+
+	if _, ok := md.Annotations[annotation.HibernatedReplicas]; !ok {
+		var replicas string
+		if md.Spec.Replicas != nil {
+			replicas = strconv.Itoa(int(*md.Spec.Replicas))
+		}
+		md.Annotations[annotation.HibernatedReplicas] = replicas
+
+		for autoscaler, hibernated := range autoscalerAnnotations() {
+			v, ok := md.Annotations[autoscaler]
+			if ok {
+				md.Annotations[hibernated] = v
+			}
+		}
+
+		updated = true
+	}
+
+	if md.Spec.Replicas == nil || *md.Spec.Replicas != 0 {
+		md.Spec.Replicas = int32Ptr(0)
+		updated = true
+	}
+
+	for autoscaler, hibernated := range autoscalerAnnotations() {
+		_, ok := md.Annotations[hibernated]
+		if ok && md.Annotations[autoscaler] != "0" {
+			md.Annotations[autoscaler] = "0"
+			updated = true
+		}
+	}
+
+	return updated
+}
+
+// restore scales the node pool of the given MachineDeployment CR back to the
+// replicas and autoscaler bounds recorded by hibernate and removes the
+// recorded values. It returns true in case the given CR was changed.
+func restore(md *apiv1alpha3.MachineDeployment) (bool, error) {
+	replicas, ok := md.Annotations[annotation.HibernatedReplicas]
+	if !ok {
+		return false, nil
+	}
+
+	if replicas == "" {
+		md.Spec.Replicas = nil
+	} else {
+		r, err := strconv.Atoi(replicas)
+		if err != nil {
+			return false, microerror.Maskf(invalidAnnotationError, "annotation %#q must be an integer, got %#q", annotation.HibernatedReplicas, replicas)
+		}
+		md.Spec.Replicas = int32Ptr(int32(r))
+	}
+	delete(md.Annotations, annotation.HibernatedReplicas)
+
+	for autoscaler, hibernated := range autoscalerAnnotations() {
+		v, ok := md.Annotations[hibernated]
+		if ok {
+			md.Annotations[autoscaler] = v
+			delete(md.Annotations, hibernated)
+		}
+	}
+
+	return true, nil
+}
+
+// autoscalerAnnotations maps the autoscaler bounds of node pools to the
+// annotations recording them during hibernation.
+func autoscalerAnnotations() map[string]string {
+	return map[string]string{
+		annotation.AutoscalerMaxSize: annotation.HibernatedMaxSize,
+		annotation.AutoscalerMinSize: annotation.HibernatedMinSize,
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,127 @@
+package updatemachinedeployments
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+)
+
+func Test_hibernate_restore(t *testing.T) {
+	testCases := []struct {
+		name                string
+		md                  apiv1alpha3.MachineDeployment
+		expectedHibernated  apiv1alpha3.MachineDeployment
+		expectedHibernation bool
+	}{
+		{
+			name: "case 0: node pool without autoscaler bounds",
+			md:   newMachineDeployment(int32Ptr(3), nil),
+			expectedHibernated: newMachineDeployment(int32Ptr(0), map[string]string{
+				annotation.HibernatedReplicas: "3",
+			}),
+			expectedHibernation: true,
+		},
+		{
+			name: "case 1: node pool with autoscaler bounds",
+			md: newMachineDeployment(int32Ptr(3), map[string]string{
+				annotation.AutoscalerMaxSize: "10",
+				annotation.AutoscalerMinSize: "3",
+			}),
+			expectedHibernated: newMachineDeployment(int32Ptr(0), map[string]string{
+				annotation.AutoscalerMaxSize:  "0",
+				annotation.AutoscalerMinSize:  "0",
+				annotation.HibernatedMaxSize:  "10",
+				annotation.HibernatedMinSize:  "3",
+				annotation.HibernatedReplicas: "3",
+			}),
+			expectedHibernation: true,
+		},
+		{
+			name: "case 2: node pool without replicas",
+			md:   newMachineDeployment(nil, nil),
+			expectedHibernated: newMachineDeployment(int32Ptr(0), map[string]string{
+				annotation.HibernatedReplicas: "",
+			}),
+			expectedHibernation: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			md := tc.md.DeepCopy()
+
+			hibernated := hibernate(md)
+			if hibernated != tc.expectedHibernation {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedHibernation, hibernated)
+			}
+			if !reflect.DeepEqual(*md, tc.expectedHibernated) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedHibernated, *md)
+			}
+
+			// Hibernating again must neither change the CR nor overwrite the
+			// recorded sizes.
+			hibernated = hibernate(md)
+			if hibernated {
+				t.Fatalf("expected %#v to be equal to %#v", false, hibernated)
+			}
+			if !reflect.DeepEqual(*md, tc.expectedHibernated) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedHibernated, *md)
+			}
+
+			restored, err := restore(md)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if !restored {
+				t.Fatalf("expected %#v to be equal to %#v", true, restored)
+			}
+
+			expected := tc.md.DeepCopy()
+			if expected.Annotations == nil {
+				expected.Annotations = map[string]string{}
+			}
+			if !reflect.DeepEqual(md, expected) {
+				t.Fatalf("expected %#v to be equal to %#v", expected, md)
+			}
+
+			restored, err = restore(md)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if restored {
+				t.Fatalf("expected %#v to be equal to %#v", false, restored)
+			}
+		})
+	}
+}
+
+func Test_restore_invalidAnnotation(t *testing.T) {
+	md := newMachineDeployment(int32Ptr(0), map[string]string{
+		annotation.HibernatedReplicas: "three",
+	})
+
+	_, err := restore(&md)
+	if !IsInvalidAnnotation(err) {
+		t.Fatalf("error == %#v, want matching", err)
+	}
+}
+
+func newMachineDeployment(replicas *int32, annotations map[string]string) apiv1alpha3.MachineDeployment {
+	return apiv1alpha3.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: annotations,
+			Name:        "a1b2c",
+			Namespace:   metav1.NamespaceDefault,
+		},
+		Spec: apiv1alpha3.MachineDeploymentSpec{
+			Replicas: replicas,
+		},
+	}
+}
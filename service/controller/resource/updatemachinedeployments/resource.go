@@ -18,11 +18,13 @@ type Config struct {
 // Resource implements the operatorkit resource interface to propagate the
 // following version labels from Cluster CRs to MachineDeployment CRs.
 //
-//     cluster-operator.giantswarm.io/version
-//     release.giantswarm.io/version
+//	cluster-operator.giantswarm.io/version
+//	release.giantswarm.io/version
 //
 // This process ensures to distribute the right version labels among CAPI CRs
-// during Tenant Cluster upgrades.
+// during Tenant Cluster upgrades. Additionally the node pools of tenant
+// clusters annotated with cluster-operator.giantswarm.io/hibernate are scaled
+// to zero and restored to their recorded sizes once the annotation is removed.
 type Resource struct {
 	k8sClient k8sclient.Interface
 	logger    micrologger.Logger
@@ -0,0 +1,78 @@
+package pausedresource
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/giantswarm/operatorkit/v5/pkg/resource"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/paused"
+)
+
+type Config struct {
+	Logger   micrologger.Logger
+	Paused   paused.Interface
+	Resource resource.Interface
+}
+
+// Resource skips the creation and update of the wrapped resource for paused
+// objects, so that cluster-operator can be frozen for specific tenant clusters
+// during migrations and incidents. Deletions are not affected.
+type Resource struct {
+	logger   micrologger.Logger
+	paused   paused.Interface
+	resource resource.Interface
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.Paused == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Paused must not be empty", config)
+	}
+	if config.Resource == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Resource must not be empty", config)
+	}
+
+	r := &Resource{
+		logger:   config.Logger,
+		paused:   config.Paused,
+		resource: config.Resource,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	p, err := r.paused.Paused(ctx, obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	if p {
+		r.logger.Debugf(ctx, "skipping resource %#q of paused cluster", r.resource.Name())
+		return nil
+	}
+
+	err = r.resource.EnsureCreated(ctx, obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	err := r.resource.EnsureDeleted(ctx, obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) Name() string {
+	return r.resource.Name()
+}
@@ -0,0 +1,116 @@
+package pausedresource
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	"github.com/giantswarm/operatorkit/v5/pkg/resource"
+)
+
+type fakePaused struct {
+	paused bool
+}
+
+func (p *fakePaused) Paused(ctx context.Context, obj interface{}) (bool, error) {
+	return p.paused, nil
+}
+
+type fakeResource struct {
+	name    string
+	created int
+	deleted int
+}
+
+func (r *fakeResource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	r.created++
+	return nil
+}
+
+func (r *fakeResource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	r.deleted++
+	return nil
+}
+
+func (r *fakeResource) Name() string {
+	return r.name
+}
+
+func Test_Wrap(t *testing.T) {
+	testCases := []struct {
+		name            string
+		paused          bool
+		ignored         []string
+		expectedCreated []int
+		expectedDeleted []int
+	}{
+		{
+			name:            "case 0: resources are executed for unpaused clusters",
+			paused:          false,
+			expectedCreated: []int{1, 1},
+			expectedDeleted: []int{1, 1},
+		},
+		{
+			name:            "case 1: creation is skipped for paused clusters",
+			paused:          true,
+			expectedCreated: []int{0, 0},
+			expectedDeleted: []int{1, 1},
+		},
+		{
+			name:            "case 2: ignored resources are executed for paused clusters",
+			paused:          true,
+			ignored:         []string{"status"},
+			expectedCreated: []int{0, 1},
+			expectedDeleted: []int{1, 1},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			fakeResources := []*fakeResource{
+				{name: "update"},
+				{name: "status"},
+			}
+
+			var resources []resource.Interface
+			for _, r := range fakeResources {
+				resources = append(resources, r)
+			}
+
+			c := WrapConfig{
+				Logger: microloggertest.New(),
+				Paused: &fakePaused{paused: tc.paused},
+
+				Ignored: tc.ignored,
+			}
+
+			wrapped, err := Wrap(resources, c)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			for _, r := range wrapped {
+				err = r.EnsureCreated(context.Background(), nil)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+				err = r.EnsureDeleted(context.Background(), nil)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			for j, r := range fakeResources {
+				if r.created != tc.expectedCreated[j] {
+					t.Fatalf("expected %#v to be equal to %#v", tc.expectedCreated[j], r.created)
+				}
+				if r.deleted != tc.expectedDeleted[j] {
+					t.Fatalf("expected %#v to be equal to %#v", tc.expectedDeleted[j], r.deleted)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package pausedresource
+
+import (
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"github.com/giantswarm/operatorkit/v5/pkg/resource"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/paused"
+)
+
+// WrapConfig is the configuration used to wrap resources with paused
+// resources.
+type WrapConfig struct {
+	Logger micrologger.Logger
+	Paused paused.Interface
+
+	// Ignored are the names of resources which are not wrapped and therefore
+	// executed for paused objects as well.
+	Ignored []string
+}
+
+// Wrap wraps each given resource with a paused resource and returns the list
+// of wrapped resources. Resources listed in WrapConfig.Ignored are returned as
+// they are.
+func Wrap(resources []resource.Interface, config WrapConfig) ([]resource.Interface, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.Paused == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Paused must not be empty", config)
+	}
+
+	ignored := map[string]bool{}
+	for _, n := range config.Ignored {
+		ignored[n] = true
+	}
+
+	var wrapped []resource.Interface
+
+	for _, r := range resources {
+		if ignored[r.Name()] {
+			wrapped = append(wrapped, r)
+			continue
+		}
+
+		c := Config{
+			Logger:   config.Logger,
+			Paused:   config.Paused,
+			Resource: r,
+		}
+
+		pausedResource, err := New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		wrapped = append(wrapped, pausedResource)
+	}
+
+	return wrapped, nil
+}
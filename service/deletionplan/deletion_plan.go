@@ -0,0 +1,366 @@
+package deletionplan
+
+import (
+	"context"
+	"fmt"
+
+	g8slabel "github.com/giantswarm/apiextensions/v3/pkg/label"
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/appfinalizer"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/certconfig"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/cpnamespace"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deletecrs"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deleteinfrarefs"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/deletionprotection"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/encryptionkey"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/keepforcrs"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/resource/keepforinfrarefs"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
+)
+
+type Config struct {
+	DeletionProgress deletionprogress.Interface
+	K8sClient        k8sclient.Interface
+	Logger           micrologger.Logger
+}
+
+// DeletionPlan computes the deletion plan of tenant clusters from the objects
+// the deleting resources of the cluster controller act upon. The steps of the
+// plan are ordered by the resources executing them, see planners.
+type DeletionPlan struct {
+	deletionProgress deletionprogress.Interface
+	k8sClient        k8sclient.Interface
+	logger           micrologger.Logger
+}
+
+// state holds the objects of a tenant cluster the deletion acts upon. Empty
+// fields mean the objects do not exist. The objects the deletion waits for are
+// the ones of the deletion progress, which the Deleting condition of the
+// Cluster CR is based on.
+type state struct {
+	Cluster  apiv1alpha3.Cluster
+	Progress deletionprogress.Progress
+
+	ConfigMaps    []string
+	Secrets       []string
+	EncryptionKey string
+	CertConfigs   []string
+	Apps          []string
+}
+
+// planner computes the steps of a single deleting resource of the cluster
+// controller.
+type planner struct {
+	resource string
+	steps    func(s state) []Step
+}
+
+// planners are ordered the same way the resources of the cluster controller
+// are executed. The order is verified against the cluster controller in its
+// tests.
+var planners = []planner{
+	{resource: deletionprotection.Name, steps: deletionProtectionSteps},
+	{resource: cpnamespace.Name, steps: namespaceSteps},
+	{resource: encryptionkey.Name, steps: encryptionKeySteps},
+	{resource: certconfig.Name, steps: certConfigSteps},
+	{resource: appfinalizer.Name, steps: appFinalizerSteps},
+	{resource: deletecrs.Name, steps: deleteG8sControlPlaneCRsSteps},
+	{resource: deletecrs.Name, steps: deleteMachineDeploymentCRsSteps},
+	{resource: deleteinfrarefs.Name, steps: deleteInfraRefsSteps},
+	{resource: keepforcrs.Name, steps: keepForG8sControlPlaneCRsSteps},
+	{resource: keepforcrs.Name, steps: keepForMachineDeploymentCRsSteps},
+	{resource: keepforinfrarefs.Name, steps: keepForInfraRefsSteps},
+}
+
+func New(config Config) (*DeletionPlan, error) {
+	if config.DeletionProgress == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.DeletionProgress must not be empty", config)
+	}
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	d := &DeletionPlan{
+		deletionProgress: config.DeletionProgress,
+		k8sClient:        config.K8sClient,
+		logger:           config.Logger,
+	}
+
+	return d, nil
+}
+
+func (d *DeletionPlan) Plan(ctx context.Context, clusterID string) (Plan, error) {
+	s, err := d.findState(ctx, clusterID)
+	if err != nil {
+		return Plan{}, microerror.Mask(err)
+	}
+
+	return newPlan(s), nil
+}
+
+// Resources returns the names of the cluster controller resources executing
+// the steps of deletion plans, in the order they are executed. Names occur
+// multiple times for resources the cluster controller uses multiple times,
+// e.g. deletecrs for G8sControlPlane and MachineDeployment CRs.
+func Resources() []string {
+	var resources []string
+	for _, p := range planners {
+		resources = append(resources, p.resource)
+	}
+
+	return resources
+}
+
+func (d *DeletionPlan) findState(ctx context.Context, clusterID string) (state, error) {
+	var s state
+
+	{
+		var list apiv1alpha3.ClusterList
+		err := d.k8sClient.CtrlClient().List(ctx, &list, client.MatchingLabels{label.Cluster: clusterID})
+		if err != nil {
+			return state{}, microerror.Mask(err)
+		}
+		if len(list.Items) == 0 {
+			return state{}, microerror.Maskf(notFoundError, "cluster %#q", clusterID)
+		}
+
+		s.Cluster = list.Items[0]
+	}
+
+	cl := s.Cluster
+	namespace := cl.GetNamespace()
+
+	{
+		p, err := d.deletionProgress.Progress(ctx, cl)
+		if err != nil {
+			return state{}, microerror.Mask(err)
+		}
+
+		s.Progress = p
+	}
+
+	if s.Progress.Namespace != "" {
+		o := metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", label.ManagedBy, project.Name()),
+		}
+
+		configMaps, err := d.k8sClient.K8sClient().CoreV1().ConfigMaps(clusterID).List(ctx, o)
+		if err != nil {
+			return state{}, microerror.Mask(err)
+		}
+		for _, cm := range configMaps.Items {
+			s.ConfigMaps = append(s.ConfigMaps, cm.Name)
+		}
+
+		secrets, err := d.k8sClient.K8sClient().CoreV1().Secrets(clusterID).List(ctx, o)
+		if err != nil {
+			return state{}, microerror.Mask(err)
+		}
+		for _, secret := range secrets.Items {
+			s.Secrets = append(s.Secrets, secret.Name)
+		}
+
+		apps, err := d.k8sClient.G8sClient().ApplicationV1alpha1().Apps(clusterID).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s!=%s", g8slabel.AppKubernetesName, appfinalizer.KeptApp),
+		})
+		if err != nil {
+			return state{}, microerror.Mask(err)
+		}
+		for _, app := range apps.Items {
+			if hasFinalizer(app.Finalizers, appfinalizer.Finalizer) {
+				s.Apps = append(s.Apps, app.Name)
+			}
+		}
+	}
+
+	{
+		_, err := d.k8sClient.K8sClient().CoreV1().Secrets(namespace).Get(ctx, key.EncryptionKeySecretName(&cl), metav1.GetOptions{})
+		if err == nil {
+			s.EncryptionKey = key.EncryptionKeySecretName(&cl)
+		} else if !apierrors.IsNotFound(err) {
+			return state{}, microerror.Mask(err)
+		}
+	}
+
+	{
+		list, err := d.k8sClient.G8sClient().CoreV1alpha1().CertConfigs(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", label.Cluster, clusterID),
+		})
+		if err != nil {
+			return state{}, microerror.Mask(err)
+		}
+		for _, c := range list.Items {
+			s.CertConfigs = append(s.CertConfigs, c.Name)
+		}
+	}
+
+	return s, nil
+}
+
+func newPlan(s state) Plan {
+	p := Plan{
+		ClusterID: key.ClusterID(&s.Cluster),
+	}
+
+	for _, pl := range planners {
+		for _, step := range pl.steps(s) {
+			step.Resource = pl.resource
+			p.Steps = append(p.Steps, step)
+
+			if step.Action == ActionBlock {
+				p.Blocked = true
+			}
+		}
+	}
+
+	return p
+}
+
+func deletionProtectionSteps(s state) []Step {
+	if !key.IsDeletionProtected(&s.Cluster) {
+		return nil
+	}
+
+	return []Step{
+		{Action: ActionBlock, Kind: "Cluster", Name: s.Cluster.GetName(), Namespace: s.Cluster.GetNamespace()},
+	}
+}
+
+// namespaceSteps covers the config maps and secrets in the namespace of the
+// tenant cluster, since they are not deleted by their own resources, but go
+// away with the namespace. The finalizers of the Cluster CR are kept while
+// the namespace is terminating.
+func namespaceSteps(s state) []Step {
+	ns := s.Progress.Namespace
+	if ns == "" {
+		return nil
+	}
+
+	steps := []Step{
+		{Action: ActionDelete, Kind: "Namespace", Name: ns},
+	}
+	for _, n := range s.ConfigMaps {
+		steps = append(steps, Step{Action: ActionDelete, Kind: "ConfigMap", Name: n, Namespace: ns})
+	}
+	for _, n := range s.Secrets {
+		steps = append(steps, Step{Action: ActionDelete, Kind: "Secret", Name: n, Namespace: ns})
+	}
+	steps = append(steps, Step{Action: ActionWait, Kind: "Namespace", Name: ns})
+
+	return steps
+}
+
+func encryptionKeySteps(s state) []Step {
+	if s.EncryptionKey == "" {
+		return nil
+	}
+
+	return []Step{
+		{Action: ActionDelete, Kind: "Secret", Name: s.EncryptionKey, Namespace: s.Cluster.GetNamespace()},
+	}
+}
+
+func certConfigSteps(s state) []Step {
+	var steps []Step
+	for _, n := range s.CertConfigs {
+		steps = append(steps, Step{Action: ActionDelete, Kind: "CertConfig", Name: n, Namespace: s.Cluster.GetNamespace()})
+	}
+
+	return steps
+}
+
+func appFinalizerSteps(s state) []Step {
+	var steps []Step
+	for _, n := range s.Apps {
+		steps = append(steps, Step{Action: ActionStripFinalizer, Kind: "App", Name: n, Namespace: s.Progress.Namespace})
+	}
+
+	return steps
+}
+
+func deleteG8sControlPlaneCRsSteps(s state) []Step {
+	var steps []Step
+	for _, n := range s.Progress.G8sControlPlanes {
+		steps = append(steps, Step{Action: ActionDelete, Kind: "G8sControlPlane", Name: n, Namespace: s.Cluster.GetNamespace()})
+	}
+
+	return steps
+}
+
+// deleteMachineDeploymentCRsSteps includes draining the nodes of deleted node
+// pools, which the machine deployment controller does before their
+// infrastructure is deleted.
+func deleteMachineDeploymentCRsSteps(s state) []Step {
+	var steps []Step
+	for _, n := range s.Progress.MachineDeployments {
+		steps = append(steps, Step{Action: ActionDelete, Kind: "MachineDeployment", Name: n, Namespace: s.Cluster.GetNamespace()})
+		steps = append(steps, Step{Action: ActionDrain, Kind: "MachineDeployment", Name: n, Namespace: s.Cluster.GetNamespace()})
+	}
+
+	return steps
+}
+
+func deleteInfraRefsSteps(s state) []Step {
+	if s.Progress.InfrastructureRef == "" {
+		return nil
+	}
+
+	or := key.ObjRefFromCluster(s.Cluster)
+
+	return []Step{
+		{Action: ActionDelete, Kind: or.Kind, Name: or.Name, Namespace: or.Namespace},
+	}
+}
+
+func keepForG8sControlPlaneCRsSteps(s state) []Step {
+	var steps []Step
+	for _, n := range s.Progress.G8sControlPlanes {
+		steps = append(steps, Step{Action: ActionWait, Kind: "G8sControlPlane", Name: n, Namespace: s.Cluster.GetNamespace()})
+	}
+
+	return steps
+}
+
+func keepForMachineDeploymentCRsSteps(s state) []Step {
+	var steps []Step
+	for _, n := range s.Progress.MachineDeployments {
+		steps = append(steps, Step{Action: ActionWait, Kind: "MachineDeployment", Name: n, Namespace: s.Cluster.GetNamespace()})
+	}
+
+	return steps
+}
+
+func keepForInfraRefsSteps(s state) []Step {
+	if s.Progress.InfrastructureRef == "" {
+		return nil
+	}
+
+	or := key.ObjRefFromCluster(s.Cluster)
+
+	return []Step{
+		{Action: ActionWait, Kind: or.Kind, Name: or.Name, Namespace: or.Namespace},
+	}
+}
+
+func hasFinalizer(finalizers []string, finalizer string) bool {
+	for _, f := range finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package deletionplan
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
+)
+
+func Test_newPlan(t *testing.T) {
+	testCases := []struct {
+		name         string
+		state        state
+		expectedPlan Plan
+	}{
+		{
+			name: "case 0: nothing left to delete",
+			state: state{
+				Cluster: newCluster(nil),
+			},
+			expectedPlan: Plan{
+				ClusterID: "8y5ck",
+			},
+		},
+		{
+			name: "case 1: complete tenant cluster",
+			state: state{
+				Cluster: newCluster(nil),
+				Progress: deletionprogress.Progress{
+					MachineDeployments: []string{"d3e4f"},
+					G8sControlPlanes:   []string{"a1b2c"},
+					InfrastructureRef:  "AWSCluster 8y5ck",
+					Apps:               []string{"coredns"},
+					Namespace:          "8y5ck",
+				},
+				ConfigMaps:    []string{"8y5ck-cluster-values"},
+				Secrets:       []string{"8y5ck-kubeconfig"},
+				EncryptionKey: "8y5ck-encryption",
+				CertConfigs:   []string{"8y5ck-api"},
+				Apps:          []string{"coredns"},
+			},
+			expectedPlan: Plan{
+				ClusterID: "8y5ck",
+				Steps: []Step{
+					{Action: ActionDelete, Kind: "Namespace", Name: "8y5ck", Resource: "cpnamespace"},
+					{Action: ActionDelete, Kind: "ConfigMap", Name: "8y5ck-cluster-values", Namespace: "8y5ck", Resource: "cpnamespace"},
+					{Action: ActionDelete, Kind: "Secret", Name: "8y5ck-kubeconfig", Namespace: "8y5ck", Resource: "cpnamespace"},
+					{Action: ActionWait, Kind: "Namespace", Name: "8y5ck", Resource: "cpnamespace"},
+					{Action: ActionDelete, Kind: "Secret", Name: "8y5ck-encryption", Namespace: "default", Resource: "encryptionkey"},
+					{Action: ActionDelete, Kind: "CertConfig", Name: "8y5ck-api", Namespace: "default", Resource: "certconfig"},
+					{Action: ActionStripFinalizer, Kind: "App", Name: "coredns", Namespace: "8y5ck", Resource: "appfinalizer"},
+					{Action: ActionDelete, Kind: "G8sControlPlane", Name: "a1b2c", Namespace: "default", Resource: "deletecrs"},
+					{Action: ActionDelete, Kind: "MachineDeployment", Name: "d3e4f", Namespace: "default", Resource: "deletecrs"},
+					{Action: ActionDrain, Kind: "MachineDeployment", Name: "d3e4f", Namespace: "default", Resource: "deletecrs"},
+					{Action: ActionDelete, Kind: "AWSCluster", Name: "8y5ck", Namespace: "default", Resource: "deleteinfrarefs"},
+					{Action: ActionWait, Kind: "G8sControlPlane", Name: "a1b2c", Namespace: "default", Resource: "keepforcrs"},
+					{Action: ActionWait, Kind: "MachineDeployment", Name: "d3e4f", Namespace: "default", Resource: "keepforcrs"},
+					{Action: ActionWait, Kind: "AWSCluster", Name: "8y5ck", Namespace: "default", Resource: "keepforinfrarefs"},
+				},
+			},
+		},
+		{
+			name: "case 2: protected tenant cluster",
+			state: state{
+				Cluster:     newCluster(map[string]string{annotation.DeletionProtection: "production"}),
+				CertConfigs: []string{"8y5ck-api"},
+			},
+			expectedPlan: Plan{
+				ClusterID: "8y5ck",
+				Blocked:   true,
+				Steps: []Step{
+					{Action: ActionBlock, Kind: "Cluster", Name: "8y5ck", Namespace: "default", Resource: "deletionprotection"},
+					{Action: ActionDelete, Kind: "CertConfig", Name: "8y5ck-api", Namespace: "default", Resource: "certconfig"},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			p := newPlan(tc.state)
+			if !reflect.DeepEqual(p, tc.expectedPlan) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedPlan, p)
+			}
+		})
+	}
+}
+
+func newCluster(annotations map[string]string) apiv1alpha3.Cluster {
+	return apiv1alpha3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: annotations,
+			Labels: map[string]string{
+				label.Cluster: "8y5ck",
+			},
+			Name:      "8y5ck",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: apiv1alpha3.ClusterSpec{
+			InfrastructureRef: &corev1.ObjectReference{
+				APIVersion: "infrastructure.giantswarm.io/v1alpha2",
+				Kind:       "AWSCluster",
+				Name:       "8y5ck",
+				Namespace:  metav1.NamespaceDefault,
+			},
+		},
+	}
+}
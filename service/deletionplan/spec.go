@@ -0,0 +1,48 @@
+package deletionplan
+
+import (
+	"context"
+)
+
+const (
+	// ActionBlock means the deletion protection of the Cluster CR blocks the
+	// deletion, in which case none of the following steps are executed.
+	ActionBlock = "block"
+	// ActionDelete means the object gets deleted.
+	ActionDelete = "delete"
+	// ActionDrain means the nodes of the deleted node pool get drained before
+	// its infrastructure is deleted.
+	ActionDrain = "drain"
+	// ActionStripFinalizer means the finalizer of app-operator gets removed
+	// from the object.
+	ActionStripFinalizer = "strip-finalizer"
+	// ActionWait means the deletion waits for the object to be gone before the
+	// finalizers of the Cluster CR are removed.
+	ActionWait = "wait"
+)
+
+type Interface interface {
+	// Plan returns the steps the cluster controller would take deleting the
+	// tenant cluster of the given ID right now, in the order they are
+	// executed. Nothing gets mutated.
+	Plan(ctx context.Context, clusterID string) (Plan, error)
+}
+
+type Plan struct {
+	ClusterID string
+	// Blocked is true when the plan contains a step of the ActionBlock action,
+	// in which case none of the steps following it would be executed.
+	Blocked bool
+	Steps   []Step
+}
+
+type Step struct {
+	Action    string
+	Kind      string
+	Name      string
+	Namespace string
+	// Resource is the name of the cluster controller resource executing the
+	// step. Nodes are drained by the machine deployment controller once the
+	// MachineDeployment CR got deleted by this resource.
+	Resource string
+}
@@ -0,0 +1,135 @@
+package apiendpoint
+
+import (
+	"context"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/apiendpoint/internal/cache"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+)
+
+type Config struct {
+	BaseDomain basedomain.Interface
+	K8sClient  k8sclient.Interface
+}
+
+type APIEndpoint struct {
+	baseDomain basedomain.Interface
+	k8sClient  k8sclient.Interface
+
+	clusterCache *cache.Cluster
+}
+
+func New(c Config) (*APIEndpoint, error) {
+	if c.BaseDomain == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.BaseDomain must not be empty", c)
+	}
+	if c.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", c)
+	}
+
+	a := &APIEndpoint{
+		baseDomain: c.BaseDomain,
+		k8sClient:  c.K8sClient,
+
+		clusterCache: cache.NewCluster(),
+	}
+
+	return a, nil
+}
+
+func (a *APIEndpoint) APIEndpoint(ctx context.Context, obj interface{}) (string, error) {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	bd, err := a.baseDomain.BaseDomain(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	cl, err := a.cachedCluster(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	switch v := cl.GetAnnotations()[annotation.APIEndpoint]; v {
+	case "", annotation.APIEndpointPublic:
+		return key.APIEndpoint(cr, bd), nil
+	case annotation.APIEndpointInternal:
+		return key.InternalAPIEndpoint(cr, bd), nil
+	default:
+		errs := validation.IsDNS1123Subdomain(v)
+		if len(errs) != 0 {
+			return "", microerror.Maskf(invalidConfigError, "annotation %#q must be %#q, %#q or a valid FQDN: %v", annotation.APIEndpoint, annotation.APIEndpointPublic, annotation.APIEndpointInternal, errs)
+		}
+
+		return v, nil
+	}
+}
+
+func (a *APIEndpoint) cachedCluster(ctx context.Context, cr metav1.Object) (infrastructurev1alpha3.AWSCluster, error) {
+	var err error
+	var ok bool
+
+	var cluster infrastructurev1alpha3.AWSCluster
+	{
+		ck := a.clusterCache.Key(ctx, cr)
+
+		if ck == "" {
+			cluster, err = a.lookupCluster(ctx, cr)
+			if err != nil {
+				return infrastructurev1alpha3.AWSCluster{}, microerror.Mask(err)
+			}
+		} else {
+			cluster, ok = a.clusterCache.Get(ctx, ck)
+			if !ok {
+				cluster, err = a.lookupCluster(ctx, cr)
+				if err != nil {
+					return infrastructurev1alpha3.AWSCluster{}, microerror.Mask(err)
+				}
+
+				a.clusterCache.Set(ctx, ck, cluster)
+			}
+		}
+	}
+
+	return cluster, nil
+}
+
+func (a *APIEndpoint) lookupCluster(ctx context.Context, cr metav1.Object) (infrastructurev1alpha3.AWSCluster, error) {
+	var list infrastructurev1alpha3.AWSClusterList
+
+	err := a.k8sClient.CtrlClient().List(
+		ctx,
+		&list,
+		client.InNamespace(cr.GetNamespace()),
+		client.MatchingLabels{label.Cluster: key.ClusterID(cr)},
+	)
+	if err != nil {
+		return infrastructurev1alpha3.AWSCluster{}, microerror.Mask(err)
+	}
+
+	// The base domain lookup already ensures the existence of the cluster CR.
+	// Not finding it here means it was deleted in the meantime, in which case
+	// we fall back to the default endpoint.
+	if len(list.Items) == 0 {
+		return infrastructurev1alpha3.AWSCluster{}, nil
+	}
+	if len(list.Items) > 1 {
+		return infrastructurev1alpha3.AWSCluster{}, microerror.Mask(tooManyCRsError)
+	}
+
+	return list.Items[0], nil
+}
@@ -0,0 +1,110 @@
+package apiendpoint
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
+)
+
+func Test_APIEndpoint(t *testing.T) {
+	testCases := []struct {
+		name             string
+		annotation       string
+		expectedEndpoint string
+		errorMatcher     func(error) bool
+	}{
+		{
+			name:             "case 0: no annotation selects public endpoint",
+			annotation:       "",
+			expectedEndpoint: "api.8y5ck.k8s.gauss.eu-central-1.aws.gigantic.io",
+		},
+		{
+			name:             "case 1: public endpoint",
+			annotation:       annotation.APIEndpointPublic,
+			expectedEndpoint: "api.8y5ck.k8s.gauss.eu-central-1.aws.gigantic.io",
+		},
+		{
+			name:             "case 2: internal endpoint",
+			annotation:       annotation.APIEndpointInternal,
+			expectedEndpoint: "internal-api.8y5ck.k8s.gauss.eu-central-1.aws.gigantic.io",
+		},
+		{
+			name:             "case 3: custom FQDN",
+			annotation:       "api.private.example.com",
+			expectedEndpoint: "api.private.example.com",
+		},
+		{
+			name:         "case 4: invalid FQDN",
+			annotation:   "https://api.private.example.com",
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var err error
+
+			fakeK8sClient := unittest.FakeK8sClient()
+
+			var a *APIEndpoint
+			{
+				var bd basedomain.Interface
+				{
+					c := basedomain.Config{
+						K8sClient: fakeK8sClient,
+					}
+
+					bd, err = basedomain.New(c)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				c := Config{
+					BaseDomain: bd,
+					K8sClient:  fakeK8sClient,
+				}
+
+				a, err = New(c)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cl := unittest.DefaultCluster()
+			{
+				if tc.annotation != "" {
+					cl.Annotations = map[string]string{
+						annotation.APIEndpoint: tc.annotation,
+					}
+				}
+
+				err = fakeK8sClient.CtrlClient().Create(context.Background(), &cl)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			endpoint, err := a.APIEndpoint(context.Background(), &cl)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if endpoint != tc.expectedEndpoint {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedEndpoint, endpoint)
+			}
+		})
+	}
+}
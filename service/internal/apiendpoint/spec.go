@@ -0,0 +1,13 @@
+package apiendpoint
+
+import (
+	"context"
+)
+
+type Interface interface {
+	// APIEndpoint provides the FQDN of the tenant API selected for the tenant
+	// cluster of the given object. It is used by the operator's own tenant
+	// client, the generated kubeconfig, the API certificate and the cluster
+	// values.
+	APIEndpoint(ctx context.Context, obj interface{}) (string, error)
+}
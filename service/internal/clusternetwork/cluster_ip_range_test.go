@@ -1,4 +1,4 @@
-package service
+package clusternetwork
 
 import (
 	"net"
@@ -40,35 +40,35 @@ func Test_parseClusterIPRange(t *testing.T) {
 			inputCIDR:           "172.31.0.0/25",
 			expectedNetworkIP:   nil,
 			expectedAPIServerIP: nil,
-			errorMatcher:        IsInvalidConfig,
+			errorMatcher:        IsInvalidClusterNetwork,
 		},
 		{
 			name:                "case 4: invalid /27 network",
 			inputCIDR:           "172.31.0.0/27",
 			expectedNetworkIP:   nil,
 			expectedAPIServerIP: nil,
-			errorMatcher:        IsInvalidConfig,
+			errorMatcher:        IsInvalidClusterNetwork,
 		},
 		{
 			name:                "case 5: invalid IPv6 network",
 			inputCIDR:           "2001:db8:a0b:12f0::1/32",
 			expectedNetworkIP:   nil,
 			expectedAPIServerIP: nil,
-			errorMatcher:        IsInvalidConfig,
+			errorMatcher:        IsInvalidClusterNetwork,
 		},
 		{
 			name:                "case 6: invalid IPv4 network mask",
 			inputCIDR:           "172.0.0.1/33",
 			expectedNetworkIP:   nil,
 			expectedAPIServerIP: nil,
-			errorMatcher:        IsInvalidConfig,
+			errorMatcher:        IsInvalidClusterNetwork,
 		},
 		{
 			name:                "case 6: invalid CIDR",
 			inputCIDR:           "256.0.0.1/33",
 			expectedNetworkIP:   nil,
 			expectedAPIServerIP: nil,
-			errorMatcher:        IsInvalidConfig,
+			errorMatcher:        IsInvalidClusterNetwork,
 		},
 	}
 
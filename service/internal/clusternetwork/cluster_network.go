@@ -0,0 +1,260 @@
+package clusternetwork
+
+import (
+	"context"
+	"net"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork/internal/cache"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
+)
+
+const (
+	apiServerIPLastOctet = 1
+)
+
+type Config struct {
+	K8sClient k8sclient.Interface
+	PodCIDR   podcidr.Interface
+
+	InstallationClusterDomain  string
+	InstallationClusterIPRange string
+}
+
+type ClusterNetwork struct {
+	k8sClient k8sclient.Interface
+	podCIDR   podcidr.Interface
+
+	clusterCache *cache.Cluster
+
+	installationNetwork Network
+}
+
+func New(c Config) (*ClusterNetwork, error) {
+	if c.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", c)
+	}
+	if c.PodCIDR == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.PodCIDR must not be empty", c)
+	}
+
+	if c.InstallationClusterDomain == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.InstallationClusterDomain must not be empty", c)
+	}
+	if c.InstallationClusterIPRange == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.InstallationClusterIPRange must not be empty", c)
+	}
+
+	n, err := newNetwork(c.InstallationClusterDomain, c.InstallationClusterIPRange)
+	if IsInvalidClusterNetwork(err) {
+		return nil, microerror.Maskf(invalidConfigError, "%T is invalid: %s", c, err)
+	} else if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	cn := &ClusterNetwork{
+		k8sClient: c.K8sClient,
+		podCIDR:   c.PodCIDR,
+
+		clusterCache: cache.NewCluster(),
+
+		installationNetwork: n,
+	}
+
+	return cn, nil
+}
+
+func (c *ClusterNetwork) ClusterNetwork(ctx context.Context, obj interface{}) (Network, error) {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return Network{}, microerror.Mask(err)
+	}
+
+	cl, err := c.cachedCluster(ctx, cr)
+	if err != nil {
+		return Network{}, microerror.Mask(err)
+	}
+
+	// Without AWSCluster CR there is nothing to override, e.g. while the
+	// cluster is being deleted. The installation defaults were already
+	// validated on startup.
+	if cl.GetName() == "" {
+		return c.installationNetwork, nil
+	}
+
+	clusterDomain := c.installationNetwork.ClusterDomain
+	if v := cl.GetAnnotations()[annotation.ClusterDomain]; v != "" {
+		clusterDomain = v
+	}
+	clusterIPRange := c.installationNetwork.ClusterIPRange
+	if v := cl.GetAnnotations()[annotation.ClusterIPRange]; v != "" {
+		clusterIPRange = v
+	}
+
+	n, err := newNetwork(clusterDomain, clusterIPRange)
+	if err != nil {
+		return Network{}, microerror.Mask(err)
+	}
+
+	// The service CIDR must neither overlap with the pod CIDR nor with the VPC
+	// of the tenant cluster. Otherwise traffic to services would be routed
+	// wrongly.
+	{
+		podCIDR, err := c.podCIDR.PodCIDR(ctx, cr)
+		if err != nil {
+			return Network{}, microerror.Mask(err)
+		}
+
+		err = checkOverlap(n.ClusterIPRange, "pod CIDR", podCIDR)
+		if err != nil {
+			return Network{}, microerror.Mask(err)
+		}
+
+		err = checkOverlap(n.ClusterIPRange, "VPC CIDR", cl.Status.Provider.Network.CIDR)
+		if err != nil {
+			return Network{}, microerror.Mask(err)
+		}
+	}
+
+	return n, nil
+}
+
+func (c *ClusterNetwork) cachedCluster(ctx context.Context, cr metav1.Object) (infrastructurev1alpha3.AWSCluster, error) {
+	var err error
+	var ok bool
+
+	var cluster infrastructurev1alpha3.AWSCluster
+	{
+		ck := c.clusterCache.Key(ctx, cr)
+
+		if ck == "" {
+			cluster, err = c.lookupCluster(ctx, cr)
+			if err != nil {
+				return infrastructurev1alpha3.AWSCluster{}, microerror.Mask(err)
+			}
+		} else {
+			cluster, ok = c.clusterCache.Get(ctx, ck)
+			if !ok {
+				cluster, err = c.lookupCluster(ctx, cr)
+				if err != nil {
+					return infrastructurev1alpha3.AWSCluster{}, microerror.Mask(err)
+				}
+
+				c.clusterCache.Set(ctx, ck, cluster)
+			}
+		}
+	}
+
+	return cluster, nil
+}
+
+func (c *ClusterNetwork) lookupCluster(ctx context.Context, cr metav1.Object) (infrastructurev1alpha3.AWSCluster, error) {
+	var list infrastructurev1alpha3.AWSClusterList
+
+	err := c.k8sClient.CtrlClient().List(
+		ctx,
+		&list,
+		client.InNamespace(cr.GetNamespace()),
+		client.MatchingLabels{label.Cluster: key.ClusterID(cr)},
+	)
+	if err != nil {
+		return infrastructurev1alpha3.AWSCluster{}, microerror.Mask(err)
+	}
+
+	if len(list.Items) == 0 {
+		return infrastructurev1alpha3.AWSCluster{}, nil
+	}
+	if len(list.Items) > 1 {
+		return infrastructurev1alpha3.AWSCluster{}, microerror.Mask(tooManyCRsError)
+	}
+
+	return list.Items[0], nil
+}
+
+func checkOverlap(clusterIPRange string, name string, cidr string) error {
+	if cidr == "" {
+		return nil
+	}
+
+	_, a, err := net.ParseCIDR(clusterIPRange)
+	if err != nil {
+		return microerror.Maskf(invalidClusterNetworkError, "invalid Kubernetes ClusterIPRange '%s': %q", clusterIPRange, err)
+	}
+	_, b, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return microerror.Maskf(invalidClusterNetworkError, "invalid %s '%s': %q", name, cidr, err)
+	}
+
+	if a.Contains(b.IP) || b.Contains(a.IP) {
+		return microerror.Maskf(invalidClusterNetworkError, "Kubernetes ClusterIPRange '%s' overlaps with %s '%s'", clusterIPRange, name, cidr)
+	}
+
+	return nil
+}
+
+func newNetwork(clusterDomain string, clusterIPRange string) (Network, error) {
+	errs := validation.IsDNS1123Subdomain(clusterDomain)
+	if len(errs) != 0 {
+		return Network{}, microerror.Maskf(invalidClusterNetworkError, "invalid cluster domain '%s': %v", clusterDomain, errs)
+	}
+
+	_, apiServerIP, err := parseClusterIPRange(clusterIPRange)
+	if err != nil {
+		return Network{}, microerror.Mask(err)
+	}
+
+	// The cluster IP range is normalized to its network address, e.g.
+	// 192.168.12.16/24 becomes 192.168.12.0/24. Parsing can not fail anymore
+	// at this point.
+	_, cidr, _ := net.ParseCIDR(clusterIPRange)
+	normalized := cidr.String()
+
+	dnsIP, err := key.DNSIP(normalized)
+	if err != nil {
+		return Network{}, microerror.Maskf(invalidClusterNetworkError, "invalid Kubernetes ClusterIPRange '%s': %s", clusterIPRange, err)
+	}
+
+	n := Network{
+		APIIP:          apiServerIP.String(),
+		ClusterDomain:  clusterDomain,
+		ClusterIPRange: normalized,
+		DNSIP:          dnsIP,
+	}
+
+	return n, nil
+}
+
+func parseClusterIPRange(ipRange string) (net.IP, net.IP, error) {
+	_, cidr, err := net.ParseCIDR(ipRange)
+	if cidr == nil {
+		return nil, nil, microerror.Maskf(invalidClusterNetworkError, "invalid Kubernetes ClusterIPRange '%s': cidr == nil", ipRange)
+	} else if err != nil {
+		return nil, nil, microerror.Maskf(invalidClusterNetworkError, "invalid Kubernetes ClusterIPRange '%s': %q", ipRange, err)
+	}
+
+	ones, bits := cidr.Mask.Size()
+	if bits != 32 {
+		return nil, nil, microerror.Maskf(invalidClusterNetworkError, "Kubernetes ClusterIPRange CIDR must be an IPv4 range")
+	}
+
+	// Node gets /24 from Kubernetes and each POD receives one IP from this
+	// block. Therefore CIDR block must be at least /24.
+	if ones > 24 {
+		return nil, nil, microerror.Maskf(invalidClusterNetworkError, "Kubernetes ClusterIPRange CIDR network block must be at least /24")
+	}
+
+	networkIP := cidr.IP.To4()
+	apiServerIP := net.IPv4(networkIP[0], networkIP[1], networkIP[2], apiServerIPLastOctet)
+
+	return networkIP, apiServerIP, nil
+}
@@ -0,0 +1,157 @@
+package clusternetwork
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
+)
+
+func Test_ClusterNetwork(t *testing.T) {
+	testCases := []struct {
+		name            string
+		annotations     map[string]string
+		podCIDR         string
+		expectedNetwork Network
+		errorMatcher    func(error) bool
+	}{
+		{
+			name: "case 0: no annotations select installation defaults",
+			expectedNetwork: Network{
+				APIIP:          "172.31.0.1",
+				ClusterDomain:  "cluster.local",
+				ClusterIPRange: "172.31.0.0/16",
+				DNSIP:          "172.31.0.10",
+			},
+		},
+		{
+			name: "case 1: custom cluster domain and cluster IP range",
+			annotations: map[string]string{
+				annotation.ClusterDomain:  "eu-central-1.example.internal",
+				annotation.ClusterIPRange: "192.168.0.0/20",
+			},
+			expectedNetwork: Network{
+				APIIP:          "192.168.0.1",
+				ClusterDomain:  "eu-central-1.example.internal",
+				ClusterIPRange: "192.168.0.0/20",
+				DNSIP:          "192.168.0.10",
+			},
+		},
+		{
+			name: "case 2: cluster IP range is normalized",
+			annotations: map[string]string{
+				annotation.ClusterIPRange: "192.168.12.16/24",
+			},
+			expectedNetwork: Network{
+				APIIP:          "192.168.12.1",
+				ClusterDomain:  "cluster.local",
+				ClusterIPRange: "192.168.12.0/24",
+				DNSIP:          "192.168.12.10",
+			},
+		},
+		{
+			name: "case 3: invalid cluster domain",
+			annotations: map[string]string{
+				annotation.ClusterDomain: "Cluster_Local",
+			},
+			errorMatcher: IsInvalidClusterNetwork,
+		},
+		{
+			name: "case 4: too small cluster IP range",
+			annotations: map[string]string{
+				annotation.ClusterIPRange: "192.168.0.0/26",
+			},
+			errorMatcher: IsInvalidClusterNetwork,
+		},
+		{
+			name: "case 5: cluster IP range overlapping with pod CIDR",
+			annotations: map[string]string{
+				annotation.ClusterIPRange: "10.2.128.0/24",
+			},
+			errorMatcher: IsInvalidClusterNetwork,
+		},
+		{
+			name: "case 6: cluster IP range overlapping with VPC CIDR",
+			annotations: map[string]string{
+				annotation.ClusterIPRange: "10.0.0.0/16",
+			},
+			errorMatcher: IsInvalidClusterNetwork,
+		},
+		{
+			name:         "case 7: installation cluster IP range overlapping with custom pod CIDR",
+			podCIDR:      "172.31.0.0/20",
+			errorMatcher: IsInvalidClusterNetwork,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var err error
+
+			fakeK8sClient := unittest.FakeK8sClient()
+
+			var cn *ClusterNetwork
+			{
+				var pc podcidr.Interface
+				{
+					c := podcidr.Config{
+						K8sClient: fakeK8sClient,
+
+						InstallationCIDR:        "10.2.0.0/16",
+						InstallationServiceCIDR: "172.31.0.0/16",
+					}
+
+					pc, err = podcidr.New(c)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				c := Config{
+					K8sClient: fakeK8sClient,
+					PodCIDR:   pc,
+
+					InstallationClusterDomain:  "cluster.local",
+					InstallationClusterIPRange: "172.31.0.0/16",
+				}
+
+				cn, err = New(c)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cl := unittest.DefaultCluster()
+			{
+				cl.Annotations = tc.annotations
+				cl.Spec.Provider.Pods.CIDRBlock = tc.podCIDR
+
+				err = fakeK8sClient.CtrlClient().Create(context.Background(), &cl)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			n, err := cn.ClusterNetwork(context.Background(), &cl)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !reflect.DeepEqual(n, tc.expectedNetwork) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedNetwork, n)
+			}
+		})
+	}
+}
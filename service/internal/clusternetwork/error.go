@@ -0,0 +1,31 @@
+package clusternetwork
+
+import "github.com/giantswarm/microerror"
+
+var invalidClusterNetworkError = &microerror.Error{
+	Kind: "invalidClusterNetworkError",
+}
+
+// IsInvalidClusterNetwork asserts invalidClusterNetworkError.
+func IsInvalidClusterNetwork(err error) bool {
+	return microerror.Cause(err) == invalidClusterNetworkError
+}
+
+var invalidConfigError = &microerror.Error{
+	Kind: "invalidConfigError",
+}
+
+// IsInvalidConfig asserts invalidConfigError.
+func IsInvalidConfig(err error) bool {
+	return microerror.Cause(err) == invalidConfigError
+}
+
+var tooManyCRsError = &microerror.Error{
+	Kind: "tooManyCRsError",
+	Desc: "There is only a single AWSCluster CR allowed with the current implementation.",
+}
+
+// IsTooManyCRsError asserts tooManyCRsError.
+func IsTooManyCRsError(err error) bool {
+	return microerror.Cause(err) == tooManyCRsError
+}
@@ -0,0 +1,26 @@
+package clusternetwork
+
+import (
+	"context"
+)
+
+type Interface interface {
+	// ClusterNetwork provides the service network settings of the tenant
+	// cluster of the given object depending on the installation and AWSCluster
+	// CR configuration. The CR values are prefered over the default values of
+	// the installation. Invalid or overlapping settings are rejected with an
+	// invalidClusterNetworkError.
+	ClusterNetwork(ctx context.Context, obj interface{}) (Network, error)
+}
+
+// Network describes the service network of a tenant cluster.
+type Network struct {
+	// APIIP is the IP of the Kubernetes API service, e.g. 172.31.0.1.
+	APIIP string
+	// ClusterDomain is the cluster domain, e.g. cluster.local.
+	ClusterDomain string
+	// ClusterIPRange is the service CIDR, e.g. 172.31.0.0/16.
+	ClusterIPRange string
+	// DNSIP is the IP of the DNS service, e.g. 172.31.0.10.
+	DNSIP string
+}
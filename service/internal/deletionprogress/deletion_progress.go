@@ -0,0 +1,144 @@
+package deletionprogress
+
+import (
+	"context"
+	"fmt"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+type Config struct {
+	K8sClient k8sclient.Interface
+	Logger    micrologger.Logger
+}
+
+type DeletionProgress struct {
+	k8sClient k8sclient.Interface
+	logger    micrologger.Logger
+}
+
+func New(c Config) (*DeletionProgress, error) {
+	if c.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", c)
+	}
+	if c.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", c)
+	}
+
+	d := &DeletionProgress{
+		k8sClient: c.K8sClient,
+		logger:    c.Logger,
+	}
+
+	return d, nil
+}
+
+func (d *DeletionProgress) Progress(ctx context.Context, cl apiv1alpha3.Cluster) (Progress, error) {
+	var p Progress
+
+	{
+		d.logger.Debugf(ctx, "finding MachineDeployments for tenant cluster")
+
+		var list apiv1alpha3.MachineDeploymentList
+		err := d.k8sClient.CtrlClient().List(
+			ctx,
+			&list,
+			client.InNamespace(cl.GetNamespace()),
+			client.MatchingLabels{label.Cluster: key.ClusterID(&cl)},
+		)
+		if err != nil {
+			return Progress{}, microerror.Mask(err)
+		}
+		for _, md := range list.Items {
+			p.MachineDeployments = append(p.MachineDeployments, md.Name)
+		}
+
+		d.logger.Debugf(ctx, "found %d MachineDeployments for tenant cluster", len(p.MachineDeployments))
+	}
+
+	{
+		d.logger.Debugf(ctx, "finding G8sControlPlanes for tenant cluster")
+
+		var list infrastructurev1alpha3.G8sControlPlaneList
+		err := d.k8sClient.CtrlClient().List(
+			ctx,
+			&list,
+			client.InNamespace(cl.GetNamespace()),
+			client.MatchingLabels{label.Cluster: key.ClusterID(&cl)},
+		)
+		if err != nil {
+			return Progress{}, microerror.Mask(err)
+		}
+		for _, cp := range list.Items {
+			p.G8sControlPlanes = append(p.G8sControlPlanes, cp.Name)
+		}
+
+		d.logger.Debugf(ctx, "found %d G8sControlPlanes for tenant cluster", len(p.G8sControlPlanes))
+	}
+
+	if cl.Spec.InfrastructureRef != nil && cl.Spec.InfrastructureRef.Name != "" && cl.Spec.InfrastructureRef.Namespace != "" {
+		d.logger.Debugf(ctx, "finding infrastructure reference")
+
+		or := key.ObjRefFromCluster(cl)
+
+		ir := &unstructured.Unstructured{}
+		ir.SetAPIVersion(or.APIVersion)
+		ir.SetKind(or.Kind)
+
+		err := d.k8sClient.CtrlClient().Get(ctx, key.ObjRefToNamespacedName(or), ir)
+		if apierrors.IsNotFound(err) {
+			d.logger.Debugf(ctx, "did not find infrastructure reference")
+		} else if err != nil {
+			return Progress{}, microerror.Mask(err)
+		} else {
+			p.InfrastructureRef = fmt.Sprintf("%s %s", or.Kind, or.Name)
+
+			d.logger.Debugf(ctx, "found infrastructure reference")
+		}
+	}
+
+	{
+		d.logger.Debugf(ctx, "finding apps for tenant cluster")
+
+		list, err := d.k8sClient.G8sClient().ApplicationV1alpha1().Apps(key.ClusterID(&cl)).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return Progress{}, microerror.Mask(err)
+		}
+		for _, a := range list.Items {
+			p.Apps = append(p.Apps, a.Name)
+		}
+
+		d.logger.Debugf(ctx, "found %d apps for tenant cluster", len(p.Apps))
+	}
+
+	{
+		d.logger.Debugf(ctx, "finding namespace %#q", key.ClusterID(&cl))
+
+		var ns corev1.Namespace
+		err := d.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: key.ClusterID(&cl)}, &ns)
+		if apierrors.IsNotFound(err) {
+			d.logger.Debugf(ctx, "did not find namespace %#q", key.ClusterID(&cl))
+		} else if err != nil {
+			return Progress{}, microerror.Mask(err)
+		} else {
+			p.Namespace = ns.Name
+
+			d.logger.Debugf(ctx, "found namespace %#q", key.ClusterID(&cl))
+		}
+	}
+
+	return p, nil
+}
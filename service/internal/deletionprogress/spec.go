@@ -0,0 +1,63 @@
+package deletionprogress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+)
+
+type Interface interface {
+	// Progress returns the objects of the tenant cluster of the given Cluster
+	// CR its deletion is still waiting for.
+	Progress(ctx context.Context, cl apiv1alpha3.Cluster) (Progress, error)
+}
+
+// Progress describes the objects of a tenant cluster the deletion of the
+// Cluster CR is still waiting for. The fields are ordered the same way the
+// deletecrs and keepfor* resources process them.
+type Progress struct {
+	// MachineDeployments are the names of the remaining MachineDeployment CRs.
+	MachineDeployments []string
+	// G8sControlPlanes are the names of the remaining G8sControlPlane CRs.
+	G8sControlPlanes []string
+	// InfrastructureRef is the kind and name of the remaining infrastructure
+	// reference, e.g. AWSCluster 8y5ck.
+	InfrastructureRef string
+	// Apps are the names of the remaining App CRs.
+	Apps []string
+	// Namespace is the name of the remaining namespace of the tenant cluster.
+	Namespace string
+}
+
+// Blockers returns a description of every pending deletion step naming the
+// objects it waits for. It is empty once nothing blocks the deletion anymore.
+func (p Progress) Blockers() []string {
+	var blockers []string
+
+	if len(p.MachineDeployments) > 0 {
+		blockers = append(blockers, fmt.Sprintf("MachineDeployments %s", sortedList(p.MachineDeployments)))
+	}
+	if len(p.G8sControlPlanes) > 0 {
+		blockers = append(blockers, fmt.Sprintf("G8sControlPlanes %s", sortedList(p.G8sControlPlanes)))
+	}
+	if p.InfrastructureRef != "" {
+		blockers = append(blockers, p.InfrastructureRef)
+	}
+	if len(p.Apps) > 0 {
+		blockers = append(blockers, fmt.Sprintf("apps %s", sortedList(p.Apps)))
+	}
+	if p.Namespace != "" {
+		blockers = append(blockers, fmt.Sprintf("namespace %s", p.Namespace))
+	}
+
+	return blockers
+}
+
+func sortedList(l []string) string {
+	s := append([]string{}, l...)
+	sort.Strings(s)
+	return strings.Join(s, ", ")
+}
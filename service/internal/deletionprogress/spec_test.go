@@ -0,0 +1,58 @@
+package deletionprogress
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func Test_Progress_Blockers(t *testing.T) {
+	testCases := []struct {
+		name             string
+		progress         Progress
+		expectedBlockers []string
+	}{
+		{
+			name:             "case 0: nothing pending",
+			progress:         Progress{},
+			expectedBlockers: nil,
+		},
+		{
+			name: "case 1: everything pending",
+			progress: Progress{
+				MachineDeployments: []string{"d3e4f", "a1b2c"},
+				G8sControlPlanes:   []string{"8y5ck"},
+				InfrastructureRef:  "AWSCluster 8y5ck",
+				Apps:               []string{"coredns", "app-operator-8y5ck"},
+				Namespace:          "8y5ck",
+			},
+			expectedBlockers: []string{
+				"MachineDeployments a1b2c, d3e4f",
+				"G8sControlPlanes 8y5ck",
+				"AWSCluster 8y5ck",
+				"apps app-operator-8y5ck, coredns",
+				"namespace 8y5ck",
+			},
+		},
+		{
+			name: "case 2: namespace pending",
+			progress: Progress{
+				Namespace: "8y5ck",
+			},
+			expectedBlockers: []string{
+				"namespace 8y5ck",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			blockers := tc.progress.Blockers()
+			if !reflect.DeepEqual(blockers, tc.expectedBlockers) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedBlockers, blockers)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package nodecount
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
@@ -11,34 +12,44 @@ import (
 
 	"github.com/giantswarm/cluster-operator/v3/pkg/label"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount/internal/cache"
-	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantinformer"
 )
 
 type Config struct {
-	K8sClient    k8sclient.Interface
-	TenantClient tenantclient.Interface
+	K8sClient      k8sclient.Interface
+	TenantInformer tenantinformer.Interface
+
+	Provider string
 }
 
 type NodeCount struct {
-	k8sClient    k8sclient.Interface
-	tenantClient tenantclient.Interface
+	k8sClient      k8sclient.Interface
+	tenantInformer tenantinformer.Interface
 
 	nodesCache *cache.Nodes
+
+	versionLabel string
 }
 
 func New(c Config) (*NodeCount, error) {
 	if c.K8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", c)
 	}
-	if c.TenantClient == nil {
-		return nil, microerror.Maskf(invalidConfigError, "%T.TenatClient must not be empty", c)
+	if c.TenantInformer == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TenantInformer must not be empty", c)
+	}
+
+	if c.Provider == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Provider must not be empty", c)
 	}
 
 	nc := &NodeCount{
-		k8sClient:    c.K8sClient,
-		tenantClient: c.TenantClient,
+		k8sClient:      c.K8sClient,
+		tenantInformer: c.TenantInformer,
 
 		nodesCache: cache.NewNodes(),
+
+		versionLabel: fmt.Sprintf("%s-operator.giantswarm.io/version", c.Provider),
 	}
 
 	return nc, nil
@@ -61,6 +72,10 @@ func (nc *NodeCount) MasterCount(ctx context.Context, obj interface{}) (map[stri
 			{
 				val := masterCount[id]
 				val.Nodes++
+				if val.Versions == nil {
+					val.Versions = map[string]int32{}
+				}
+				val.Versions[node.Labels[nc.versionLabel]]++
 				masterCount[id] = val
 			}
 			for _, c := range node.Status.Conditions {
@@ -94,6 +109,10 @@ func (nc *NodeCount) WorkerCount(ctx context.Context, obj interface{}) (map[stri
 			{
 				val := workerCount[id]
 				val.Nodes++
+				if val.Versions == nil {
+					val.Versions = map[string]int32{}
+				}
+				val.Versions[node.Labels[nc.versionLabel]]++
 				workerCount[id] = val
 			}
 			for _, c := range node.Status.Conditions {
@@ -139,19 +158,14 @@ func (nc *NodeCount) cachedNodes(ctx context.Context, cr metav1.Object) (corev1.
 }
 
 func (nc *NodeCount) lookupNodes(ctx context.Context, cr metav1.Object) (corev1.NodeList, error) {
-	client, err := nc.tenantClient.K8sClient(ctx, cr)
-	if err != nil {
-		return corev1.NodeList{}, microerror.Mask(err)
-	}
-
-	nodes, err := client.K8sClient().CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	nodes, err := nc.tenantInformer.Nodes(ctx, cr)
 	if err != nil {
 		return corev1.NodeList{}, microerror.Mask(err)
 	}
 
-	if len(nodes.Items) == 0 {
+	if len(nodes) == 0 {
 		return corev1.NodeList{}, nil
 	}
 
-	return *nodes, nil
+	return corev1.NodeList{Items: nodes}, nil
 }
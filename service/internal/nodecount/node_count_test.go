@@ -8,7 +8,7 @@ import (
 	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/cachekeycontext"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	tcunittest "github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient/unittest"
+	tiunittest "github.com/giantswarm/cluster-operator/v3/service/internal/tenantinformer/unittest"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
 )
 
@@ -50,8 +50,10 @@ func Test_NodeCount_Cache(t *testing.T) {
 			{
 				fakeK8sClient := unittest.FakeK8sClient()
 				c := Config{
-					K8sClient:    fakeK8sClient,
-					TenantClient: tcunittest.FakeTenantClient(fakeK8sClient),
+					K8sClient:      fakeK8sClient,
+					TenantInformer: tiunittest.FakeTenantInformer(fakeK8sClient),
+
+					Provider: "aws",
 				}
 
 				nc, err = New(c)
@@ -108,6 +110,9 @@ func Test_NodeCount_Cache(t *testing.T) {
 			if masterNodes2[controlPlaneValue].Nodes != tc.expectNodeCount {
 				t.Fatalf("expected %v to be equal to %v", tc.expectNodeCount, masterNodes2[controlPlaneValue].Nodes)
 			}
+			if masterNodes2[controlPlaneValue].Upgraded("8.7.5") != tc.expectNodeCount {
+				t.Fatalf("expected %v to be equal to %v", tc.expectNodeCount, masterNodes2[controlPlaneValue].Upgraded("8.7.5"))
+			}
 			if tc.expectCaching {
 				if masterNodes1[controlPlaneValue].Nodes != masterNodes2[controlPlaneValue].Nodes {
 					t.Fatalf("expected %v to be equal to %v", masterNodes1[controlPlaneValue].Nodes, masterNodes2[controlPlaneValue].Nodes)
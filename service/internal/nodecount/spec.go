@@ -19,4 +19,15 @@ type Interface interface {
 type Node struct {
 	Nodes int32
 	Ready int32
+	// Versions is a map of key value pairs where the key is the provider
+	// operator version nodes are labelled with, e.g. the value of
+	// aws-operator.giantswarm.io/version. The map value is the number of nodes
+	// labelled with that version.
+	Versions map[string]int32
+}
+
+// Upgraded returns the number of nodes running the given provider operator
+// version.
+func (n Node) Upgraded(version string) int32 {
+	return n.Versions[version]
 }
@@ -0,0 +1,37 @@
+package orphansweeper
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	KindApp        = "App"
+	KindCertConfig = "CertConfig"
+	KindConfigMap  = "ConfigMap"
+	KindNamespace  = "Namespace"
+	KindSecret     = "Secret"
+)
+
+type Interface interface {
+	// Boot sweeps orphaned resources periodically until the given context is
+	// done.
+	Boot(ctx context.Context) error
+	// Deleted returns the number of orphaned resources deleted so far per
+	// kind.
+	Deleted() map[string]uint64
+	// Orphans returns the orphaned resources found by the latest sweep.
+	Orphans() []Orphan
+}
+
+// Orphan is a resource managed by cluster-operator which belongs to a tenant
+// cluster without Cluster CR.
+type Orphan struct {
+	ClusterID string
+	Kind      string
+	Name      string
+	// Namespace is empty for cluster scoped resources.
+	Namespace string
+	// Since is the time the resource was first found orphaned.
+	Since time.Time
+}
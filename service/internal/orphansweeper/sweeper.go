@@ -0,0 +1,349 @@
+package orphansweeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/pkg/project"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+type Config struct {
+	K8sClient k8sclient.Interface
+	Logger    micrologger.Logger
+
+	// Delete defines whether orphaned resources are deleted once they exceeded
+	// the grace period. Otherwise the sweeper only reports the resources it
+	// would delete.
+	Delete bool
+	// GracePeriod is the duration a resource has to be found orphaned before
+	// it is deleted.
+	GracePeriod time.Duration
+	// Interval is the duration between two sweeps.
+	Interval time.Duration
+}
+
+// Sweeper finds resources managed by cluster-operator which are labelled with
+// the ID of a tenant cluster without Cluster CR, e.g. because the tenant
+// cluster was deleted incompletely. Orphaned resources are tracked from the
+// sweep they were first found in, so that the grace period starts over when
+// the operator restarts.
+type Sweeper struct {
+	k8sClient k8sclient.Interface
+	logger    micrologger.Logger
+
+	delete      bool
+	gracePeriod time.Duration
+	interval    time.Duration
+
+	mutex   sync.Mutex
+	deleted map[string]uint64
+	orphans []Orphan
+}
+
+func New(config Config) (*Sweeper, error) {
+	if config.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if config.GracePeriod <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.GracePeriod must be greater than zero", config)
+	}
+	if config.Interval <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Interval must be greater than zero", config)
+	}
+
+	s := &Sweeper{
+		k8sClient: config.K8sClient,
+		logger:    config.Logger,
+
+		delete:      config.Delete,
+		gracePeriod: config.GracePeriod,
+		interval:    config.Interval,
+
+		deleted: map[string]uint64{},
+	}
+
+	return s, nil
+}
+
+func (s *Sweeper) Boot(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		err := s.sweep(ctx)
+		if err != nil {
+			s.logger.LogCtx(ctx, "level", "warning", "message", "failed to sweep orphaned resources", "stack", microerror.JSON(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Sweeper) Deleted() map[string]uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deleted := map[string]uint64{}
+	for k, n := range s.deleted {
+		deleted[k] = n
+	}
+
+	return deleted
+}
+
+func (s *Sweeper) Orphans() []Orphan {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]Orphan{}, s.orphans...)
+}
+
+func (s *Sweeper) sweep(ctx context.Context) error {
+	clusterIDs, err := s.findClusterIDs(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	managed, err := s.findManagedResources(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	now := time.Now()
+
+	s.mutex.Lock()
+	orphans := findOrphans(managed, clusterIDs, s.orphans, now)
+	s.orphans = orphans
+	s.mutex.Unlock()
+
+	s.logger.Debugf(ctx, "found %d orphaned resources", len(orphans))
+
+	for _, o := range orphans {
+		if !graceExpired(o.Since, s.gracePeriod, now) {
+			continue
+		}
+
+		// Without deletion enabled the sweeper runs in dry-run mode and only
+		// reports what it would delete.
+		if !s.delete {
+			s.logger.Debugf(ctx, "dry run: would delete orphaned %s %#q of tenant cluster %#q, orphaned since %s", o.Kind, orphanName(o), o.ClusterID, o.Since.Format(time.RFC3339))
+			continue
+		}
+
+		s.logger.Debugf(ctx, "deleting orphaned %s %#q of tenant cluster %#q", o.Kind, orphanName(o), o.ClusterID)
+
+		err = s.deleteOrphan(ctx, o)
+		if apierrors.IsNotFound(err) {
+			s.logger.Debugf(ctx, "orphaned %s %#q of tenant cluster %#q already deleted", o.Kind, orphanName(o), o.ClusterID)
+			continue
+		} else if err != nil {
+			return microerror.Mask(err)
+		}
+
+		s.mutex.Lock()
+		s.deleted[o.Kind]++
+		s.mutex.Unlock()
+
+		s.logger.Debugf(ctx, "deleted orphaned %s %#q of tenant cluster %#q", o.Kind, orphanName(o), o.ClusterID)
+	}
+
+	return nil
+}
+
+// findClusterIDs returns the IDs of all tenant clusters having a Cluster CR,
+// including the ones being deleted.
+func (s *Sweeper) findClusterIDs(ctx context.Context) (map[string]bool, error) {
+	var list apiv1alpha3.ClusterList
+	err := s.k8sClient.CtrlClient().List(ctx, &list)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	clusterIDs := map[string]bool{}
+	for _, cl := range list.Items {
+		cl := cl // dereferencing pointer value into new scope
+
+		clusterIDs[cl.GetName()] = true
+		if key.ClusterID(&cl) != "" {
+			clusterIDs[key.ClusterID(&cl)] = true
+		}
+	}
+
+	return clusterIDs, nil
+}
+
+// findManagedResources returns all resources labelled as managed by
+// cluster-operator which belong to a tenant cluster.
+func (s *Sweeper) findManagedResources(ctx context.Context) ([]Orphan, error) {
+	o := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{label.ManagedBy: project.Name()}).String(),
+	}
+
+	var managed []Orphan
+
+	add := func(kind string, obj metav1.Object) {
+		id := obj.GetLabels()[label.Cluster]
+		if id == "" {
+			return
+		}
+		// The orphaned resources config map records forced deletions and is
+		// meant to outlive its tenant cluster.
+		if kind == KindConfigMap && obj.GetName() == key.OrphanedResourcesConfigMapName(obj) {
+			return
+		}
+
+		managed = append(managed, Orphan{
+			ClusterID: id,
+			Kind:      kind,
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+		})
+	}
+
+	{
+		list, err := s.k8sClient.K8sClient().CoreV1().ConfigMaps(metav1.NamespaceAll).List(ctx, o)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		for i := range list.Items {
+			add(KindConfigMap, &list.Items[i])
+		}
+	}
+
+	{
+		list, err := s.k8sClient.K8sClient().CoreV1().Secrets(metav1.NamespaceAll).List(ctx, o)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		for i := range list.Items {
+			add(KindSecret, &list.Items[i])
+		}
+	}
+
+	{
+		list, err := s.k8sClient.K8sClient().CoreV1().Namespaces().List(ctx, o)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		for i := range list.Items {
+			add(KindNamespace, &list.Items[i])
+		}
+	}
+
+	{
+		list, err := s.k8sClient.G8sClient().CoreV1alpha1().CertConfigs(metav1.NamespaceAll).List(ctx, o)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		for i := range list.Items {
+			add(KindCertConfig, &list.Items[i])
+		}
+	}
+
+	{
+		list, err := s.k8sClient.G8sClient().ApplicationV1alpha1().Apps(metav1.NamespaceAll).List(ctx, o)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+		for i := range list.Items {
+			add(KindApp, &list.Items[i])
+		}
+	}
+
+	return managed, nil
+}
+
+func (s *Sweeper) deleteOrphan(ctx context.Context, o Orphan) error {
+	var err error
+
+	switch o.Kind {
+	case KindApp:
+		err = s.k8sClient.G8sClient().ApplicationV1alpha1().Apps(o.Namespace).Delete(ctx, o.Name, metav1.DeleteOptions{})
+	case KindCertConfig:
+		err = s.k8sClient.G8sClient().CoreV1alpha1().CertConfigs(o.Namespace).Delete(ctx, o.Name, metav1.DeleteOptions{})
+	case KindConfigMap:
+		err = s.k8sClient.K8sClient().CoreV1().ConfigMaps(o.Namespace).Delete(ctx, o.Name, metav1.DeleteOptions{})
+	case KindNamespace:
+		err = s.k8sClient.K8sClient().CoreV1().Namespaces().Delete(ctx, o.Name, metav1.DeleteOptions{})
+	case KindSecret:
+		err = s.k8sClient.K8sClient().CoreV1().Secrets(o.Namespace).Delete(ctx, o.Name, metav1.DeleteOptions{})
+	default:
+		return microerror.Maskf(executionFailedError, "unknown kind %#q", o.Kind)
+	}
+
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+// findOrphans returns the managed resources belonging to tenant clusters not
+// contained in the given cluster IDs. Resources found orphaned by the
+// previous sweep keep the time they were first found orphaned, all others are
+// orphaned since now.
+func findOrphans(managed []Orphan, clusterIDs map[string]bool, previous []Orphan, now time.Time) []Orphan {
+	since := map[string]time.Time{}
+	for _, o := range previous {
+		since[orphanKey(o)] = o.Since
+	}
+
+	var orphans []Orphan
+	for _, o := range managed {
+		if clusterIDs[o.ClusterID] {
+			continue
+		}
+
+		o.Since = now
+		if t, ok := since[orphanKey(o)]; ok {
+			o.Since = t
+		}
+
+		orphans = append(orphans, o)
+	}
+
+	sort.Slice(orphans, func(i, j int) bool {
+		return orphanKey(orphans[i]) < orphanKey(orphans[j])
+	})
+
+	return orphans
+}
+
+// graceExpired returns true when a resource orphaned since the given time
+// exceeded the given grace period.
+func graceExpired(since time.Time, gracePeriod time.Duration, now time.Time) bool {
+	return !now.Before(since.Add(gracePeriod))
+}
+
+func orphanKey(o Orphan) string {
+	return fmt.Sprintf("%s/%s/%s", o.Kind, o.Namespace, o.Name)
+}
+
+func orphanName(o Orphan) string {
+	if o.Namespace == "" {
+		return o.Name
+	}
+
+	return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+}
@@ -0,0 +1,112 @@
+package orphansweeper
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_findOrphans(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+
+	testCases := []struct {
+		name            string
+		managed         []Orphan
+		clusterIDs      map[string]bool
+		previous        []Orphan
+		expectedOrphans []Orphan
+	}{
+		{
+			name: "case 0: all resources belong to existing clusters",
+			managed: []Orphan{
+				{ClusterID: "8y5ck", Kind: KindConfigMap, Name: "8y5ck-cluster-values", Namespace: "8y5ck"},
+				{ClusterID: "8y5ck", Kind: KindNamespace, Name: "8y5ck"},
+			},
+			clusterIDs: map[string]bool{
+				"8y5ck": true,
+			},
+			expectedOrphans: nil,
+		},
+		{
+			name: "case 1: resources of deleted clusters are orphaned since now",
+			managed: []Orphan{
+				{ClusterID: "8y5ck", Kind: KindConfigMap, Name: "8y5ck-cluster-values", Namespace: "8y5ck"},
+				{ClusterID: "al9qy", Kind: KindSecret, Name: "al9qy-kubeconfig", Namespace: "default"},
+				{ClusterID: "al9qy", Kind: KindNamespace, Name: "al9qy"},
+			},
+			clusterIDs: map[string]bool{
+				"8y5ck": true,
+			},
+			expectedOrphans: []Orphan{
+				{ClusterID: "al9qy", Kind: KindNamespace, Name: "al9qy", Since: now},
+				{ClusterID: "al9qy", Kind: KindSecret, Name: "al9qy-kubeconfig", Namespace: "default", Since: now},
+			},
+		},
+		{
+			name: "case 2: resources orphaned before keep their time",
+			managed: []Orphan{
+				{ClusterID: "al9qy", Kind: KindSecret, Name: "al9qy-kubeconfig", Namespace: "default"},
+				{ClusterID: "al9qy", Kind: KindApp, Name: "coredns", Namespace: "al9qy"},
+			},
+			clusterIDs: map[string]bool{},
+			previous: []Orphan{
+				{ClusterID: "al9qy", Kind: KindSecret, Name: "al9qy-kubeconfig", Namespace: "default", Since: earlier},
+				{ClusterID: "al9qy", Kind: KindConfigMap, Name: "al9qy-cluster-values", Namespace: "al9qy", Since: earlier},
+			},
+			expectedOrphans: []Orphan{
+				{ClusterID: "al9qy", Kind: KindApp, Name: "coredns", Namespace: "al9qy", Since: now},
+				{ClusterID: "al9qy", Kind: KindSecret, Name: "al9qy-kubeconfig", Namespace: "default", Since: earlier},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			orphans := findOrphans(tc.managed, tc.clusterIDs, tc.previous, now)
+			if !reflect.DeepEqual(orphans, tc.expectedOrphans) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedOrphans, orphans)
+			}
+		})
+	}
+}
+
+func Test_graceExpired(t *testing.T) {
+	since := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		now             time.Time
+		expectedExpired bool
+	}{
+		{
+			name:            "case 0: just orphaned",
+			now:             since,
+			expectedExpired: false,
+		},
+		{
+			name:            "case 1: within the grace period",
+			now:             since.Add(23 * time.Hour),
+			expectedExpired: false,
+		},
+		{
+			name:            "case 2: grace period exceeded",
+			now:             since.Add(24 * time.Hour),
+			expectedExpired: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			expired := graceExpired(since, 24*time.Hour, tc.now)
+			if expired != tc.expectedExpired {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedExpired, expired)
+			}
+		})
+	}
+}
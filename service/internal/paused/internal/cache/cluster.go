@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/cachekeycontext"
+	gocache "github.com/patrickmn/go-cache"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+type Cluster struct {
+	cache *gocache.Cache
+}
+
+func NewCluster() *Cluster {
+	r := &Cluster{
+		cache: gocache.New(expiration, expiration/2),
+	}
+
+	return r
+}
+
+func (r *Cluster) Get(ctx context.Context, key string) (apiv1alpha3.Cluster, bool) {
+	val, ok := r.cache.Get(key)
+	if ok {
+		return val.(apiv1alpha3.Cluster), true
+	}
+
+	return apiv1alpha3.Cluster{}, false
+}
+
+func (r *Cluster) Key(ctx context.Context, obj metav1.Object) string {
+	ck, ok := cachekeycontext.FromContext(ctx)
+	if ok {
+		return fmt.Sprintf("%s/%s", ck, key.ClusterID(obj))
+	}
+
+	return ""
+}
+
+func (r *Cluster) Set(ctx context.Context, key string, val apiv1alpha3.Cluster) {
+	r.cache.SetDefault(key, val)
+}
@@ -0,0 +1,110 @@
+package paused
+
+import (
+	"context"
+
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/util/annotations"
+
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/paused/internal/cache"
+)
+
+type Config struct {
+	K8sClient k8sclient.Interface
+}
+
+type Paused struct {
+	k8sClient k8sclient.Interface
+
+	clusterCache *cache.Cluster
+}
+
+func New(c Config) (*Paused, error) {
+	if c.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", c)
+	}
+
+	p := &Paused{
+		k8sClient: c.K8sClient,
+
+		clusterCache: cache.NewCluster(),
+	}
+
+	return p, nil
+}
+
+func (p *Paused) Paused(ctx context.Context, obj interface{}) (bool, error) {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return false, microerror.Mask(err)
+	}
+
+	if annotations.HasPausedAnnotation(cr) {
+		return true, nil
+	}
+
+	// Cluster CRs are checked right away. Any other object is paused as long
+	// as the Cluster CR of its tenant cluster is paused.
+	cl, ok := obj.(*apiv1alpha3.Cluster)
+	if !ok {
+		c, err := p.cachedCluster(ctx, cr)
+		if IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, microerror.Mask(err)
+		}
+
+		cl = &c
+	}
+
+	return annotations.IsPaused(cl, cl), nil
+}
+
+func (p *Paused) cachedCluster(ctx context.Context, cr metav1.Object) (apiv1alpha3.Cluster, error) {
+	var err error
+	var ok bool
+
+	var cluster apiv1alpha3.Cluster
+	{
+		ck := p.clusterCache.Key(ctx, cr)
+
+		if ck == "" {
+			cluster, err = p.lookupCluster(ctx, cr)
+			if err != nil {
+				return apiv1alpha3.Cluster{}, microerror.Mask(err)
+			}
+		} else {
+			cluster, ok = p.clusterCache.Get(ctx, ck)
+			if !ok {
+				cluster, err = p.lookupCluster(ctx, cr)
+				if err != nil {
+					return apiv1alpha3.Cluster{}, microerror.Mask(err)
+				}
+
+				p.clusterCache.Set(ctx, ck, cluster)
+			}
+		}
+	}
+
+	return cluster, nil
+}
+
+func (p *Paused) lookupCluster(ctx context.Context, cr metav1.Object) (apiv1alpha3.Cluster, error) {
+	var cl apiv1alpha3.Cluster
+
+	err := p.k8sClient.CtrlClient().Get(ctx, types.NamespacedName{Name: key.ClusterID(cr), Namespace: cr.GetNamespace()}, &cl)
+	if apierrors.IsNotFound(err) {
+		return apiv1alpha3.Cluster{}, microerror.Mask(notFoundError)
+	} else if err != nil {
+		return apiv1alpha3.Cluster{}, microerror.Mask(err)
+	}
+
+	return cl, nil
+}
@@ -0,0 +1,124 @@
+package paused
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
+)
+
+func Test_Paused(t *testing.T) {
+	newCluster := func(paused bool, annotations map[string]string) *apiv1alpha3.Cluster {
+		return &apiv1alpha3.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "8y5ck",
+				Namespace:   "default",
+				Annotations: annotations,
+				Labels: map[string]string{
+					label.Cluster: "8y5ck",
+				},
+			},
+			Spec: apiv1alpha3.ClusterSpec{
+				Paused: paused,
+			},
+		}
+	}
+
+	newMachineDeployment := func(annotations map[string]string) *apiv1alpha3.MachineDeployment {
+		md := unittest.DefaultMachineDeployment()
+		md.SetName("a1b2c")
+		md.SetNamespace("default")
+		md.SetAnnotations(annotations)
+		md.SetLabels(map[string]string{
+			label.Cluster: "8y5ck",
+		})
+		return &md
+	}
+
+	testCases := []struct {
+		name           string
+		cluster        *apiv1alpha3.Cluster
+		obj            interface{}
+		expectedPaused bool
+	}{
+		{
+			name:           "case 0: cluster not paused",
+			obj:            newCluster(false, nil),
+			expectedPaused: false,
+		},
+		{
+			name:           "case 1: cluster paused by flag",
+			obj:            newCluster(true, nil),
+			expectedPaused: true,
+		},
+		{
+			name:           "case 2: cluster paused by annotation",
+			obj:            newCluster(false, map[string]string{apiv1alpha3.PausedAnnotation: ""}),
+			expectedPaused: true,
+		},
+		{
+			name:           "case 3: machine deployment of unpaused cluster",
+			cluster:        newCluster(false, nil),
+			obj:            newMachineDeployment(nil),
+			expectedPaused: false,
+		},
+		{
+			name:           "case 4: machine deployment of paused cluster",
+			cluster:        newCluster(true, nil),
+			obj:            newMachineDeployment(nil),
+			expectedPaused: true,
+		},
+		{
+			name:           "case 5: machine deployment paused by annotation",
+			cluster:        newCluster(false, nil),
+			obj:            newMachineDeployment(map[string]string{apiv1alpha3.PausedAnnotation: ""}),
+			expectedPaused: true,
+		},
+		{
+			name:           "case 6: machine deployment without cluster",
+			obj:            newMachineDeployment(nil),
+			expectedPaused: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var err error
+
+			var p *Paused
+			{
+				c := Config{
+					K8sClient: unittest.FakeK8sClient(),
+				}
+
+				p, err = New(c)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			if tc.cluster != nil {
+				err = p.k8sClient.CtrlClient().Create(context.Background(), tc.cluster)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			paused, err := p.Paused(context.Background(), tc.obj)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if paused != tc.expectedPaused {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedPaused, paused)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package paused
+
+import (
+	"context"
+)
+
+type Interface interface {
+	// Paused returns whether the given object is paused. Objects are paused
+	// when they carry the Cluster API paused annotation themselves or when
+	// the Cluster CR of their tenant cluster is paused, either by its paused
+	// flag or by the paused annotation.
+	Paused(ctx context.Context, obj interface{}) (bool, error)
+}
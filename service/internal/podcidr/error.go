@@ -20,6 +20,15 @@ func IsNotFound(err error) bool {
 	return microerror.Cause(err) == notFoundError
 }
 
+var poolExhaustedError = &microerror.Error{
+	Kind: "poolExhaustedError",
+}
+
+// IsPoolExhausted asserts poolExhaustedError.
+func IsPoolExhausted(err error) bool {
+	return microerror.Cause(err) == poolExhaustedError
+}
+
 var tooManyCRsError = &microerror.Error{
 	Kind: "tooManyCRsError",
 	Desc: "There is only a single AWSCluster CR allowed with the current implementation.",
@@ -0,0 +1,224 @@
+package podcidr
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sort"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+const (
+	// maxPrefixLength is the smallest pod CIDR we allocate. Calico assigns
+	// blocks of /26 to nodes, so anything smaller would not even serve a
+	// handful of nodes.
+	maxPrefixLength = 24
+)
+
+func (p *PodCIDR) Allocate(ctx context.Context, obj interface{}) (string, error) {
+	if p.pool == nil {
+		return "", nil
+	}
+
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	// Allocations must be serialized. Otherwise two Tenant Clusters reconciled
+	// at the same time could be assigned the same pod CIDR.
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// The Tenant Cluster is looked up without cache here so that we never
+	// allocate twice for the same cluster.
+	cl, err := p.lookupCluster(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if cl.Spec.Provider.Pods.CIDRBlock != "" {
+		return "", nil
+	}
+
+	// Tenant Clusters which are already being created or were created without
+	// pod CIDR use the installation CIDR. Allocating a pod CIDR for them would
+	// renumber the pod network of running clusters.
+	if cl.Status.Cluster.HasCreatingCondition() || cl.Status.Cluster.HasCreatedCondition() {
+		return "", nil
+	}
+
+	var list infrastructurev1alpha3.AWSClusterList
+	{
+		err = p.k8sClient.CtrlClient().List(ctx, &list)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+	}
+
+	// Besides the pod CIDRs of other Tenant Clusters, neither the installation
+	// CIDR used by Tenant Clusters not specifying a pod CIDR nor the service
+	// CIDR of the Tenant Cluster may be allocated. The latter would be
+	// rejected when the cluster network is validated.
+	used := []*net.IPNet{
+		parseCIDR(p.installationCIDR),
+		parseCIDR(p.serviceCIDR(cl)),
+	}
+	for _, c := range list.Items {
+		n := parseCIDR(c.Spec.Provider.Pods.CIDRBlock)
+		if n != nil {
+			used = append(used, n)
+		}
+	}
+
+	cidr, err := p.nextFree(used)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	{
+		cl.Spec.Provider.Pods.CIDRBlock = cidr.String()
+
+		err = p.k8sClient.CtrlClient().Update(ctx, &cl)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+	}
+
+	return cidr.String(), nil
+}
+
+func (p *PodCIDR) Conflicts(ctx context.Context) (map[string][]string, error) {
+	var list infrastructurev1alpha3.AWSClusterList
+	{
+		err := p.k8sClient.CtrlClient().List(ctx, &list)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	type cluster struct {
+		cidr        *net.IPNet
+		id          string
+		serviceCIDR *net.IPNet
+		// shared is true for Tenant Clusters using the installation CIDR.
+		shared bool
+	}
+
+	var clusters []cluster
+	for _, c := range list.Items {
+		c := c // dereferencing pointer value into new scope
+
+		cl := cluster{
+			cidr:        parseCIDR(c.Spec.Provider.Pods.CIDRBlock),
+			id:          key.ClusterID(&c),
+			serviceCIDR: parseCIDR(p.serviceCIDR(c)),
+		}
+		if c.Spec.Provider.Pods.CIDRBlock == "" {
+			cl.cidr = parseCIDR(p.installationCIDR)
+			cl.shared = true
+		}
+
+		if cl.cidr != nil {
+			clusters = append(clusters, cl)
+		}
+	}
+
+	conflicts := map[string][]string{}
+	for i := range clusters {
+		for j := range clusters {
+			if i == j {
+				if clusters[i].serviceCIDR != nil && overlaps(clusters[i].cidr, clusters[i].serviceCIDR) {
+					conflicts[clusters[i].id] = append(conflicts[clusters[i].id], clusters[i].id)
+				}
+				continue
+			}
+			if clusters[i].shared && clusters[j].shared {
+				continue
+			}
+			if overlaps(clusters[i].cidr, clusters[j].cidr) {
+				conflicts[clusters[i].id] = append(conflicts[clusters[i].id], clusters[j].id)
+			}
+		}
+	}
+
+	for id := range conflicts {
+		sort.Strings(conflicts[id])
+	}
+
+	return conflicts, nil
+}
+
+// nextFree returns the first CIDR of the configured prefix length within the
+// pool which does not overlap with any of the given CIDRs.
+func (p *PodCIDR) nextFree(used []*net.IPNet) (*net.IPNet, error) {
+	ones, _ := p.pool.Mask.Size()
+
+	start := binary.BigEndian.Uint32(p.pool.IP.To4())
+	size := uint32(1) << uint(32-p.poolPrefixLength)
+	count := uint32(1) << uint(p.poolPrefixLength-ones)
+
+	for i := uint32(0); i < count; i++ {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, start+i*size)
+
+		candidate := &net.IPNet{
+			IP:   ip,
+			Mask: net.CIDRMask(p.poolPrefixLength, 32),
+		}
+
+		var conflict bool
+		for _, u := range used {
+			if overlaps(candidate, u) {
+				conflict = true
+				break
+			}
+		}
+
+		if !conflict {
+			return candidate, nil
+		}
+	}
+
+	return nil, microerror.Maskf(poolExhaustedError, "no free /%d left in pod CIDR pool %s", p.poolPrefixLength, p.pool)
+}
+
+// serviceCIDR returns the service CIDR of the given Tenant Cluster, which is
+// either configured per cluster or the installation service CIDR.
+func (p *PodCIDR) serviceCIDR(cl infrastructurev1alpha3.AWSCluster) string {
+	if v := cl.GetAnnotations()[annotation.ClusterIPRange]; v != "" {
+		return v
+	}
+
+	return p.installationServiceCIDR
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// parseCIDR returns the network of the given CIDR or nil if it is empty or
+// invalid. Invalid CIDRs are ignored for allocation and conflict detection,
+// they are rejected elsewhere when the cluster network is validated.
+func parseCIDR(s string) *net.IPNet {
+	if s == "" {
+		return nil
+	}
+
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil
+	}
+
+	return n
+}
@@ -0,0 +1,252 @@
+package podcidr
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
+)
+
+func Test_PodCIDR_Allocate(t *testing.T) {
+	testCases := []struct {
+		name              string
+		pool              string
+		cidrBlock         string
+		conditions        []infrastructurev1alpha3.CommonClusterStatusCondition
+		otherCIDRBlocks   []string
+		expectedAllocated string
+		expectedCIDRBlock string
+		errorMatcher      func(error) bool
+	}{
+		{
+			name:              "case 0: allocation disabled without pool",
+			pool:              "",
+			expectedAllocated: "",
+			expectedCIDRBlock: "",
+		},
+		{
+			name:              "case 1: first CIDR of the pool",
+			pool:              "10.64.0.0/14",
+			expectedAllocated: "10.64.0.0/16",
+			expectedCIDRBlock: "10.64.0.0/16",
+		},
+		{
+			name:              "case 2: CIDRs used by other clusters are skipped",
+			pool:              "10.64.0.0/14",
+			otherCIDRBlocks:   []string{"10.64.0.0/16", "10.65.128.0/20"},
+			expectedAllocated: "10.66.0.0/16",
+			expectedCIDRBlock: "10.66.0.0/16",
+		},
+		{
+			name:              "case 3: existing CIDR is kept",
+			pool:              "10.64.0.0/14",
+			cidrBlock:         "192.168.0.0/16",
+			expectedAllocated: "",
+			expectedCIDRBlock: "192.168.0.0/16",
+		},
+		{
+			name:            "case 4: exhausted pool",
+			pool:            "10.64.0.0/15",
+			otherCIDRBlocks: []string{"10.64.0.0/16", "10.65.0.0/16"},
+			errorMatcher:    IsPoolExhausted,
+		},
+		{
+			name:              "case 5: installation CIDR is skipped",
+			pool:              "10.0.0.0/14",
+			otherCIDRBlocks:   []string{"10.0.0.0/16", "10.1.0.0/16"},
+			expectedAllocated: "10.3.0.0/16",
+			expectedCIDRBlock: "10.3.0.0/16",
+		},
+		{
+			name:            "case 6: service CIDR is skipped",
+			pool:            "172.30.0.0/15",
+			otherCIDRBlocks: []string{"172.30.0.0/16"},
+			errorMatcher:    IsPoolExhausted,
+		},
+		{
+			name:              "case 7: existing cluster using the installation CIDR is kept",
+			pool:              "10.64.0.0/14",
+			conditions:        []infrastructurev1alpha3.CommonClusterStatusCondition{unittest.GetCreatingCondition(90), unittest.GetCreatedCondition(60)},
+			expectedAllocated: "",
+			expectedCIDRBlock: "",
+		},
+		{
+			name:              "case 8: cluster being created using the installation CIDR is kept",
+			pool:              "10.64.0.0/14",
+			conditions:        []infrastructurev1alpha3.CommonClusterStatusCondition{unittest.GetCreatingCondition(5)},
+			expectedAllocated: "",
+			expectedCIDRBlock: "",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var err error
+
+			var pc *PodCIDR
+			{
+				c := Config{
+					K8sClient: unittest.FakeK8sClient(),
+
+					InstallationCIDR:        "10.2.0.0/16",
+					InstallationServiceCIDR: "172.31.0.0/16",
+					Pool:                    tc.pool,
+					PoolPrefixLength:        16,
+				}
+
+				pc, err = New(c)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for j, b := range tc.otherCIDRBlocks {
+				cl := newCluster(strconv.Itoa(j), b)
+				err = pc.k8sClient.CtrlClient().Create(context.Background(), &cl)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cl := unittest.DefaultCluster()
+			{
+				cl.Spec.Provider.Pods.CIDRBlock = tc.cidrBlock
+				cl.Status.Cluster.Conditions = tc.conditions
+				err = pc.k8sClient.CtrlClient().Create(context.Background(), &cl)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			allocated, err := pc.Allocate(context.Background(), &cl)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if tc.errorMatcher != nil {
+				return
+			}
+
+			if allocated != tc.expectedAllocated {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedAllocated, allocated)
+			}
+
+			{
+				err = pc.k8sClient.CtrlClient().Get(context.Background(), client.ObjectKey{Name: cl.Name, Namespace: cl.Namespace}, &cl)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if cl.Spec.Provider.Pods.CIDRBlock != tc.expectedCIDRBlock {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedCIDRBlock, cl.Spec.Provider.Pods.CIDRBlock)
+			}
+		})
+	}
+}
+
+func Test_PodCIDR_Conflicts(t *testing.T) {
+	testCases := []struct {
+		name              string
+		cidrBlocks        []string
+		expectedConflicts map[string][]string
+	}{
+		{
+			name:              "case 0: no clusters",
+			cidrBlocks:        nil,
+			expectedConflicts: map[string][]string{},
+		},
+		{
+			name:              "case 1: disjoint and installation CIDRs",
+			cidrBlocks:        []string{"10.64.0.0/16", "10.65.0.0/16", "", ""},
+			expectedConflicts: map[string][]string{},
+		},
+		{
+			name:       "case 2: overlapping CIDRs",
+			cidrBlocks: []string{"10.64.0.0/16", "10.64.128.0/20", "10.65.0.0/16", "10.64.0.0/14"},
+			expectedConflicts: map[string][]string{
+				"0": {"1", "3"},
+				"1": {"0", "3"},
+				"2": {"3"},
+				"3": {"0", "1", "2"},
+			},
+		},
+		{
+			name:       "case 3: CIDR overlapping with the installation CIDR",
+			cidrBlocks: []string{"10.2.128.0/20", "", ""},
+			expectedConflicts: map[string][]string{
+				"0": {"1", "2"},
+				"1": {"0"},
+				"2": {"0"},
+			},
+		},
+		{
+			name:       "case 4: CIDR overlapping with the service CIDR",
+			cidrBlocks: []string{"172.31.0.0/20", "10.64.0.0/16"},
+			expectedConflicts: map[string][]string{
+				"0": {"0"},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var err error
+
+			var pc *PodCIDR
+			{
+				c := Config{
+					K8sClient: unittest.FakeK8sClient(),
+
+					InstallationCIDR:        "10.2.0.0/16",
+					InstallationServiceCIDR: "172.31.0.0/16",
+				}
+
+				pc, err = New(c)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for j, b := range tc.cidrBlocks {
+				cl := newCluster(strconv.Itoa(j), b)
+				err = pc.k8sClient.CtrlClient().Create(context.Background(), &cl)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			conflicts, err := pc.Conflicts(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(conflicts, tc.expectedConflicts) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedConflicts, conflicts)
+			}
+		})
+	}
+}
+
+func newCluster(id string, cidrBlock string) infrastructurev1alpha3.AWSCluster {
+	cl := unittest.DefaultCluster()
+	cl.Labels[label.Cluster] = id
+	cl.Name = id
+	cl.Spec.Provider.Pods.CIDRBlock = cidrBlock
+
+	return cl
+}
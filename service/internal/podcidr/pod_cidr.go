@@ -2,6 +2,8 @@ package podcidr
 
 import (
 	"context"
+	"net"
+	"sync"
 
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
@@ -19,14 +21,28 @@ type Config struct {
 	K8sClient k8sclient.Interface
 
 	InstallationCIDR string
+	// InstallationServiceCIDR is the service CIDR of Tenant Clusters not
+	// specifying one on their own, which must not be allocated as pod CIDR.
+	InstallationServiceCIDR string
+	// Pool is the CIDR from which pod CIDRs are allocated for Tenant Clusters
+	// which do not specify one on their own. Allocation is disabled when the
+	// pool is empty, in which case the installation CIDR is used.
+	Pool string
+	// PoolPrefixLength is the prefix length of the pod CIDRs allocated from
+	// Pool, e.g. 16 for 10.2.0.0/16.
+	PoolPrefixLength int
 }
 
 type PodCIDR struct {
 	k8sClient k8sclient.Interface
 
 	clusterCache *cache.Cluster
+	mutex        sync.Mutex
 
-	installationCIDR string
+	installationCIDR        string
+	installationServiceCIDR string
+	pool                    *net.IPNet
+	poolPrefixLength        int
 }
 
 func New(c Config) (*PodCIDR, error) {
@@ -37,13 +53,37 @@ func New(c Config) (*PodCIDR, error) {
 	if c.InstallationCIDR == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.InstallationCIDR must not be empty", c)
 	}
+	if c.InstallationServiceCIDR == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.InstallationServiceCIDR must not be empty", c)
+	}
+
+	var pool *net.IPNet
+	if c.Pool != "" {
+		var err error
+		_, pool, err = net.ParseCIDR(c.Pool)
+		if err != nil {
+			return nil, microerror.Maskf(invalidConfigError, "%T.Pool must be a valid CIDR: %s", c, err)
+		}
+
+		ones, bits := pool.Mask.Size()
+		if bits != 32 {
+			return nil, microerror.Maskf(invalidConfigError, "%T.Pool must be an IPv4 CIDR", c)
+		}
+		if c.PoolPrefixLength < ones || c.PoolPrefixLength > maxPrefixLength {
+			return nil, microerror.Maskf(invalidConfigError, "%T.PoolPrefixLength must be between %d and %d", c, ones, maxPrefixLength)
+		}
+	}
 
 	p := &PodCIDR{
 		k8sClient: c.K8sClient,
 
 		clusterCache: cache.NewCluster(),
+		mutex:        sync.Mutex{},
 
-		installationCIDR: c.InstallationCIDR,
+		installationCIDR:        c.InstallationCIDR,
+		installationServiceCIDR: c.InstallationServiceCIDR,
+		pool:                    pool,
+		poolPrefixLength:        c.PoolPrefixLength,
 	}
 
 	return p, nil
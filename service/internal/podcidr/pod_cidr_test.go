@@ -62,7 +62,8 @@ func Test_PodCIDR_Cache(t *testing.T) {
 				c := Config{
 					K8sClient: unittest.FakeK8sClient(),
 
-					InstallationCIDR: "installation-cidr",
+					InstallationCIDR:        "installation-cidr",
+					InstallationServiceCIDR: "172.31.0.0/16",
 				}
 
 				pc, err = New(c)
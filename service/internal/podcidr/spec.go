@@ -9,4 +9,22 @@ type Interface interface {
 	// the installation and AWSCluster CR configuration. The CR value is prefered
 	// over the default value in the installation.
 	PodCIDR(ctx context.Context, obj interface{}) (string, error)
+
+	// Allocate allocates a pod CIDR from the configured pool for the Tenant
+	// Cluster of the given object and persists it in the AWSCluster CR. The
+	// allocated CIDR does not overlap with the pod CIDR of any other Tenant
+	// Cluster. The empty string is returned in case nothing had to be
+	// allocated, either because the CR already specifies a pod CIDR, because
+	// the Tenant Cluster is already being created or was created using the
+	// installation CIDR, or because no pool is configured.
+	Allocate(ctx context.Context, obj interface{}) (string, error)
+
+	// Conflicts returns the IDs of all Tenant Clusters having a pod CIDR
+	// overlapping with the pod CIDR of another Tenant Cluster, mapped to the
+	// IDs of the Tenant Clusters they overlap with. Tenant Clusters not
+	// specifying a pod CIDR use the installation CIDR, which is shared by
+	// design, so they only conflict with Tenant Clusters specifying a pod CIDR
+	// overlapping with it. Tenant Clusters whose pod CIDR overlaps with their
+	// own service CIDR are mapped to their own ID.
+	Conflicts(ctx context.Context) (map[string][]string, error)
 }
@@ -44,6 +44,12 @@ func (r *Recorder) Emit(ctx context.Context, obj pkgruntime.Object, reason, mess
 	r.Event(obj, corev1.EventTypeNormal, reason, upper(message))
 }
 
+// EmitWarning writes warning events for conditions which require attention
+// but are not reconciliation errors, e.g. an unreachable tenant API.
+func (r *Recorder) EmitWarning(ctx context.Context, obj pkgruntime.Object, reason, message string) {
+	r.Event(obj, corev1.EventTypeWarning, reason, upper(message))
+}
+
 // upper is a helper function to uppercase first letter of the event message
 func upper(in string) string {
 	out := []rune(in)
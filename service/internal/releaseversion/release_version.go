@@ -2,6 +2,7 @@ package releaseversion
 
 import (
 	"context"
+	"time"
 
 	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
@@ -10,17 +11,24 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion/internal/cache"
 )
 
 type Config struct {
 	K8sClient k8sclient.Interface
+
+	CreationTimeout time.Duration
+	UpdateTimeout   time.Duration
 }
 
 type ReleaseVersion struct {
 	k8sClient k8sclient.Interface
 
+	creationTimeout time.Duration
+	updateTimeout   time.Duration
+
 	releaseCache *cache.Release
 }
 
@@ -29,9 +37,19 @@ func New(c Config) (*ReleaseVersion, error) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", c)
 	}
 
+	if c.CreationTimeout <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CreationTimeout must be greater than zero", c)
+	}
+	if c.UpdateTimeout <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.UpdateTimeout must be greater than zero", c)
+	}
+
 	rv := &ReleaseVersion{
 		k8sClient: c.K8sClient,
 
+		creationTimeout: c.CreationTimeout,
+		updateTimeout:   c.UpdateTimeout,
+
 		releaseCache: cache.NewRelease(),
 	}
 
@@ -79,6 +97,34 @@ func (rv *ReleaseVersion) ComponentVersion(ctx context.Context, obj interface{})
 	return components, nil
 }
 
+func (rv *ReleaseVersion) TransitionTimeouts(ctx context.Context, obj interface{}) (TransitionTimeouts, error) {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return TransitionTimeouts{}, microerror.Mask(err)
+	}
+
+	release, err := rv.cachedRelease(ctx, cr)
+	if err != nil {
+		return TransitionTimeouts{}, microerror.Mask(err)
+	}
+
+	timeouts := TransitionTimeouts{
+		Creation: rv.creationTimeout,
+		Update:   rv.updateTimeout,
+	}
+
+	timeouts.Creation, err = parseTimeout(release.GetAnnotations(), annotation.CreationTimeout, timeouts.Creation)
+	if err != nil {
+		return TransitionTimeouts{}, microerror.Mask(err)
+	}
+	timeouts.Update, err = parseTimeout(release.GetAnnotations(), annotation.UpdateTimeout, timeouts.Update)
+	if err != nil {
+		return TransitionTimeouts{}, microerror.Mask(err)
+	}
+
+	return timeouts, nil
+}
+
 func (rv *ReleaseVersion) cachedRelease(ctx context.Context, cr metav1.Object) (releasev1alpha1.Release, error) {
 	var err error
 	var ok bool
@@ -121,3 +167,20 @@ func (rv *ReleaseVersion) lookupReleaseVersions(ctx context.Context, cr metav1.O
 
 	return re, nil
 }
+
+func parseTimeout(annotations map[string]string, name string, fallback time.Duration) (time.Duration, error) {
+	v, ok := annotations[name]
+	if !ok {
+		return fallback, nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, microerror.Maskf(invalidAnnotationError, "annotation %#q must be a duration, got %#q", name, v)
+	}
+	if d <= 0 {
+		return 0, microerror.Maskf(invalidAnnotationError, "annotation %#q must be greater than zero, got %#q", name, v)
+	}
+
+	return d, nil
+}
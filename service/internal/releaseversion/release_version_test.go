@@ -4,11 +4,13 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
 	"github.com/giantswarm/operatorkit/v5/pkg/controller/context/cachekeycontext"
 
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/unittest"
 )
 
@@ -57,6 +59,9 @@ func Test_Release_Cache(t *testing.T) {
 			{
 				c := Config{
 					K8sClient: unittest.FakeK8sClient(),
+
+					CreationTimeout: 30 * time.Minute,
+					UpdateTimeout:   2 * time.Hour,
 				}
 				rv, err = New(c)
 				if err != nil {
@@ -122,3 +127,93 @@ func Test_Release_Cache(t *testing.T) {
 		})
 	}
 }
+
+func Test_TransitionTimeouts(t *testing.T) {
+	testCases := []struct {
+		name             string
+		annotations      map[string]string
+		expectedTimeouts TransitionTimeouts
+		errorMatcher     func(error) bool
+	}{
+		{
+			name: "case 0: installation timeouts",
+			expectedTimeouts: TransitionTimeouts{
+				Creation: 30 * time.Minute,
+				Update:   2 * time.Hour,
+			},
+		},
+		{
+			name: "case 1: release timeouts",
+			annotations: map[string]string{
+				annotation.CreationTimeout: "45m",
+				annotation.UpdateTimeout:   "3h",
+			},
+			expectedTimeouts: TransitionTimeouts{
+				Creation: 45 * time.Minute,
+				Update:   3 * time.Hour,
+			},
+		},
+		{
+			name: "case 2: invalid release timeout",
+			annotations: map[string]string{
+				annotation.UpdateTimeout: "3 hours",
+			},
+			errorMatcher: IsInvalidAnnotation,
+		},
+		{
+			name: "case 3: negative release timeout",
+			annotations: map[string]string{
+				annotation.CreationTimeout: "-45m",
+			},
+			errorMatcher: IsInvalidAnnotation,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var err error
+
+			var rv *ReleaseVersion
+			{
+				c := Config{
+					K8sClient: unittest.FakeK8sClient(),
+
+					CreationTimeout: 30 * time.Minute,
+					UpdateTimeout:   2 * time.Hour,
+				}
+				rv, err = New(c)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			{
+				release := unittest.DefaultRelease()
+				release.SetAnnotations(tc.annotations)
+				err = rv.k8sClient.CtrlClient().Create(context.Background(), &release)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cl := unittest.DefaultCluster()
+
+			timeouts, err := rv.TransitionTimeouts(context.Background(), &cl)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if timeouts != tc.expectedTimeouts {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedTimeouts, timeouts)
+			}
+		})
+	}
+}
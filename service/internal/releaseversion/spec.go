@@ -2,6 +2,7 @@ package releaseversion
 
 import (
 	"context"
+	"time"
 )
 
 const (
@@ -16,6 +17,11 @@ type Interface interface {
 	Apps(ctx context.Context, obj interface{}) (map[string]ReleaseApp, error)
 	// ComponentVersion provides the version of each component in a release.
 	ComponentVersion(ctx context.Context, obj interface{}) (map[string]ReleaseComponent, error)
+	// TransitionTimeouts provides the durations after which the creation and
+	// update of a tenant cluster are considered stuck. The installation's
+	// timeouts can be overridden per release using annotations on the Release
+	// CR.
+	TransitionTimeouts(ctx context.Context, obj interface{}) (TransitionTimeouts, error)
 }
 
 type ReleaseApp struct {
@@ -33,3 +39,12 @@ type ReleaseComponent struct {
 	// Version of the component.
 	Version string `json:"version"`
 }
+
+type TransitionTimeouts struct {
+	// Creation is the duration after which the creation of a tenant cluster is
+	// considered stuck.
+	Creation time.Duration
+	// Update is the duration after which the update of a tenant cluster is
+	// considered stuck.
+	Update time.Duration
+}
@@ -0,0 +1,77 @@
+package tenantclient
+
+import (
+	"time"
+
+	"github.com/giantswarm/errors/tenant"
+)
+
+// breaker is the circuit breaker of a single tenant cluster. It opens after
+// the configured number of consecutive failures of the tenant API and stays
+// open for the cooldown period. Afterwards a single request is let through in
+// half-open state, deciding whether the breaker closes or opens again.
+type breaker struct {
+	failures int
+	probing  bool
+	since    time.Time
+	state    BreakerState
+}
+
+// allow returns true when a request against the tenant API may be made. The
+// given breaker transitions from open to half-open once the cooldown period
+// passed, in which case the caller is responsible for the probe.
+func (b *breaker) allow(cooldown time.Duration, now time.Time) bool {
+	switch b.state {
+	case BreakerOpen:
+		if now.Sub(b.since) < cooldown {
+			return false
+		}
+		b.probing = true
+		b.since = now
+		b.state = BreakerHalfOpen
+		return true
+	case BreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+
+	return true
+}
+
+// observe records the result of a request against the tenant API. Errors
+// other than the tenant API being unavailable do not affect the breaker.
+// Closed breakers without failures are dropped by the caller.
+func (b *breaker) observe(err error, threshold int, now time.Time) {
+	b.probing = false
+
+	if err == nil {
+		b.failures = 0
+		b.state = BreakerClosed
+		return
+	}
+
+	if !isTenantAPIFailure(err) {
+		return
+	}
+
+	b.failures++
+	if b.state == BreakerHalfOpen || b.failures >= threshold {
+		b.since = now
+		b.state = BreakerOpen
+	}
+}
+
+func (b *breaker) status() BreakerStatus {
+	return BreakerStatus{
+		Failures: b.failures,
+		Since:    b.since,
+		State:    b.state,
+	}
+}
+
+func isTenantAPIFailure(err error) bool {
+	return IsNotAvailable(err) || tenant.IsAPINotAvailable(err)
+}
@@ -0,0 +1,120 @@
+package tenantclient
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/microerror"
+)
+
+func Test_breaker(t *testing.T) {
+	start := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	cooldown := 2 * time.Minute
+	threshold := 3
+
+	unavailable := microerror.Mask(notAvailableError)
+	forbidden := errors.New("forbidden")
+
+	type request struct {
+		at            time.Duration
+		err           error
+		expectedAllow bool
+		expectedState BreakerState
+	}
+
+	testCases := []struct {
+		name     string
+		requests []request
+	}{
+		{
+			name: "case 0: breaker stays closed below threshold",
+			requests: []request{
+				{at: 0, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+				{at: time.Second, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+				{at: 2 * time.Second, err: nil, expectedAllow: true, expectedState: BreakerClosed},
+				{at: 3 * time.Second, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+			},
+		},
+		{
+			name: "case 1: breaker ignores errors other than unavailability",
+			requests: []request{
+				{at: 0, err: forbidden, expectedAllow: true, expectedState: BreakerClosed},
+				{at: time.Second, err: forbidden, expectedAllow: true, expectedState: BreakerClosed},
+				{at: 2 * time.Second, err: forbidden, expectedAllow: true, expectedState: BreakerClosed},
+			},
+		},
+		{
+			name: "case 2: breaker opens at threshold and short-circuits during cooldown",
+			requests: []request{
+				{at: 0, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+				{at: time.Second, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+				{at: 2 * time.Second, err: unavailable, expectedAllow: true, expectedState: BreakerOpen},
+				{at: time.Minute, expectedAllow: false, expectedState: BreakerOpen},
+			},
+		},
+		{
+			name: "case 3: half-open breaker closes after successful probe",
+			requests: []request{
+				{at: 0, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+				{at: time.Second, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+				{at: 2 * time.Second, err: unavailable, expectedAllow: true, expectedState: BreakerOpen},
+				{at: 3 * time.Minute, err: nil, expectedAllow: true, expectedState: BreakerClosed},
+			},
+		},
+		{
+			name: "case 4: half-open breaker opens again after failed probe",
+			requests: []request{
+				{at: 0, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+				{at: time.Second, err: unavailable, expectedAllow: true, expectedState: BreakerClosed},
+				{at: 2 * time.Second, err: unavailable, expectedAllow: true, expectedState: BreakerOpen},
+				{at: 3 * time.Minute, err: unavailable, expectedAllow: true, expectedState: BreakerOpen},
+				{at: 4 * time.Minute, expectedAllow: false, expectedState: BreakerOpen},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			b := &breaker{state: BreakerClosed}
+
+			for j, r := range tc.requests {
+				now := start.Add(r.at)
+
+				allow := b.allow(cooldown, now)
+				if allow != r.expectedAllow {
+					t.Fatalf("request %d: expected %t to be equal to %t", j, r.expectedAllow, allow)
+				}
+				if allow {
+					b.observe(r.err, threshold, now)
+				}
+				if b.state != r.expectedState {
+					t.Fatalf("request %d: expected %#q to be equal to %#q", j, r.expectedState, b.state)
+				}
+			}
+		})
+	}
+}
+
+func Test_breaker_halfOpenSingleProbe(t *testing.T) {
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	b := &breaker{
+		failures: 3,
+		since:    now,
+		state:    BreakerOpen,
+	}
+
+	if !b.allow(time.Minute, now.Add(time.Minute)) {
+		t.Fatalf("expected probe to be allowed after cooldown")
+	}
+	if b.state != BreakerHalfOpen {
+		t.Fatalf("expected %#q to be equal to %#q", BreakerHalfOpen, b.state)
+	}
+	if b.allow(time.Minute, now.Add(time.Minute)) {
+		t.Fatalf("expected concurrent probe to be short-circuited")
+	}
+}
@@ -2,35 +2,84 @@ package tenantclient
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/giantswarm/certs/v3/pkg/certs"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/tenantcluster/v4/pkg/tenantcluster"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/rest"
 
 	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
-	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/apiendpoint"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient/internal/cache"
 )
 
+// buildBuckets are the upper bounds in seconds of the histogram buckets
+// tracking the time it takes to build tenant clients.
+var buildBuckets = []float64{0.1, 0.5, 1, 2.5, 5, 10, 30}
+
 type Config struct {
-	BaseDomain    basedomain.Interface
+	APIEndpoint   apiendpoint.Interface
 	K8sClient     k8sclient.Interface
 	Logger        micrologger.Logger
 	TenantCluster tenantcluster.Interface
+
+	// Cooldown is the duration the circuit breaker of a tenant cluster stays
+	// open before the tenant API is probed again.
+	Cooldown time.Duration
+	// FailureThreshold is the number of consecutive failed requests against
+	// the tenant API after which the circuit breaker of the tenant cluster
+	// opens.
+	FailureThreshold int
+	// TTL is the duration tenant clients are reused for before they are built
+	// again.
+	TTL time.Duration
 }
 
+// TenantClient pools tenant clients per cluster ID. Pooled clients are reused
+// until their TTL expires, the tenant API endpoint changes, the
+// cluster-operator API cert secret of the tenant cluster changes or they are
+// evicted, e.g. because the tenant cluster got deleted. Requests of tenant
+// clusters with unreachable tenant APIs are short-circuited by a circuit
+// breaker per cluster ID, so that reconciliations do not wait for timeouts
+// over and over again. Requests made with pooled clients are reported to the
+// circuit breakers by the transports of the pooled clients.
 type TenantClient struct {
-	baseDomain    basedomain.Interface
+	apiEndpoint   apiendpoint.Interface
 	k8sClient     k8sclient.Interface
 	logger        micrologger.Logger
 	tenantCluster tenantcluster.Interface
+
+	cooldown         time.Duration
+	failureThreshold int
+	ttl              time.Duration
+
+	certVersionCache *cache.CertVersion
+
+	mutex    sync.Mutex
+	breakers map[string]*breaker
+	clients  map[string]pooledClient
+	stats    PoolStats
+}
+
+type pooledClient struct {
+	certVersion string
+	client      k8sclient.Interface
+	created     time.Time
+	endpoint    string
 }
 
 func New(c Config) (*TenantClient, error) {
-	if c.BaseDomain == nil {
-		return nil, microerror.Maskf(invalidConfigError, "%T.BaseDomain must not be empty", c)
+	if c.APIEndpoint == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.APIEndpoint must not be empty", c)
 	}
 	if c.K8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", c)
@@ -42,11 +91,33 @@ func New(c Config) (*TenantClient, error) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.TenantCluster must not be empty", c)
 	}
 
+	if c.Cooldown <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Cooldown must be greater than zero", c)
+	}
+	if c.FailureThreshold <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.FailureThreshold must be greater than zero", c)
+	}
+	if c.TTL <= 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TTL must be greater than zero", c)
+	}
+
 	tenantClient := &TenantClient{
-		baseDomain:    c.BaseDomain,
+		apiEndpoint:   c.APIEndpoint,
 		k8sClient:     c.K8sClient,
 		logger:        c.Logger,
 		tenantCluster: c.TenantCluster,
+
+		cooldown:         c.Cooldown,
+		failureThreshold: c.FailureThreshold,
+		ttl:              c.TTL,
+
+		certVersionCache: cache.NewCertVersion(),
+
+		breakers: map[string]*breaker{},
+		clients:  map[string]pooledClient{},
+		stats: PoolStats{
+			BuildBuckets: map[float64]uint64{},
+		},
 	}
 
 	return tenantClient, nil
@@ -58,14 +129,207 @@ func (c *TenantClient) K8sClient(ctx context.Context, obj interface{}) (k8sclien
 		return nil, microerror.Mask(err)
 	}
 
-	bd, err := c.baseDomain.BaseDomain(ctx, cr)
+	id := key.ClusterID(cr)
+
+	// The breaker is checked first, so that short-circuited requests do not
+	// cause any lookups in the management cluster.
+	c.mutex.Lock()
+	b, ok := c.breakers[id]
+	if !ok {
+		b = &breaker{state: BreakerClosed}
+	}
+	if !b.allow(c.cooldown, time.Now()) {
+		c.mutex.Unlock()
+		return nil, microerror.Maskf(notAvailableError, "circuit breaker of tenant cluster %#q is open", id)
+	}
+	probe := b.state == BreakerHalfOpen
+	if probe {
+		c.breakers[id] = b
+	}
+	c.mutex.Unlock()
+
+	endpoint, err := c.apiEndpoint.APIEndpoint(ctx, cr)
+	if err != nil {
+		c.releaseProbe(id, probe, err)
+		return nil, microerror.Mask(err)
+	}
+
+	certVersion, err := c.cachedCertVersion(ctx, cr)
 	if err != nil {
+		c.releaseProbe(id, probe, err)
 		return nil, microerror.Mask(err)
 	}
 
+	c.mutex.Lock()
+	p, ok := c.clients[id]
+	c.mutex.Unlock()
+
+	var k8sClient k8sclient.Interface
+	if ok && p.endpoint == endpoint && p.certVersion == certVersion && time.Since(p.created) < c.ttl {
+		k8sClient = p.client
+	} else {
+		start := time.Now()
+
+		k8sClient, err = c.newK8sClient(ctx, id, endpoint)
+		if err != nil {
+			c.observe(id, err)
+			return nil, microerror.Mask(err)
+		}
+
+		c.mutex.Lock()
+		c.clients[id] = pooledClient{
+			certVersion: certVersion,
+			client:      k8sClient,
+			created:     time.Now(),
+			endpoint:    endpoint,
+		}
+		c.observeBuild(time.Since(start))
+		c.mutex.Unlock()
+	}
+
+	// Half-open breakers decide based on a single cheap request whether the
+	// tenant API is reachable again. Its result is reported to the breaker by
+	// the transport of the pooled client.
+	if probe {
+		_, err = k8sClient.K8sClient().Discovery().ServerVersion()
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return k8sClient, nil
+}
+
+func (c *TenantClient) Evict(ctx context.Context, obj interface{}) error {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	c.mutex.Lock()
+	delete(c.breakers, key.ClusterID(cr))
+	delete(c.clients, key.ClusterID(cr))
+	c.mutex.Unlock()
+
+	return nil
+}
+
+func (c *TenantClient) Breaker(ctx context.Context, obj interface{}) (BreakerStatus, error) {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return BreakerStatus{}, microerror.Mask(err)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	b, ok := c.breakers[key.ClusterID(cr)]
+	if !ok {
+		return BreakerStatus{State: BreakerClosed}, nil
+	}
+
+	return b.status(), nil
+}
+
+func (c *TenantClient) Stats() PoolStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stats := PoolStats{
+		Size:         len(c.clients),
+		BuildCount:   c.stats.BuildCount,
+		BuildSum:     c.stats.BuildSum,
+		BuildBuckets: map[float64]uint64{},
+		Breakers:     map[string]BreakerState{},
+	}
+	for b, n := range c.stats.BuildBuckets {
+		stats.BuildBuckets[b] = n
+	}
+	for id := range c.clients {
+		stats.Breakers[id] = BreakerClosed
+	}
+	for id, b := range c.breakers {
+		stats.Breakers[id] = b.state
+	}
+
+	return stats
+}
+
+func (c *TenantClient) Version(ctx context.Context, obj interface{}) (string, error) {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	endpoint, err := c.apiEndpoint.APIEndpoint(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	certVersion, err := c.cachedCertVersion(ctx, cr)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return fmt.Sprintf("%s/%s", endpoint, certVersion), nil
+}
+
+func (c *TenantClient) cachedCertVersion(ctx context.Context, cr metav1.Object) (string, error) {
+	var err error
+	var ok bool
+
+	var certVersion string
+	{
+		ck := c.certVersionCache.Key(ctx, cr)
+
+		if ck == "" {
+			certVersion, err = c.lookupCertVersion(ctx, key.ClusterID(cr))
+			if err != nil {
+				return "", microerror.Mask(err)
+			}
+		} else {
+			certVersion, ok = c.certVersionCache.Get(ctx, ck)
+			if !ok {
+				certVersion, err = c.lookupCertVersion(ctx, key.ClusterID(cr))
+				if err != nil {
+					return "", microerror.Mask(err)
+				}
+
+				c.certVersionCache.Set(ctx, ck, certVersion)
+			}
+		}
+	}
+
+	return certVersion, nil
+}
+
+// lookupCertVersion returns the identity of the current cluster-operator API cert
+// secret of the given tenant cluster, so that pooled clients can be built
+// again once the cert got rotated. An empty string is returned in case the
+// secret does not exist.
+func (c *TenantClient) lookupCertVersion(ctx context.Context, clusterID string) (string, error) {
+	o := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(certs.K8sLabels(clusterID, certs.ClusterOperatorAPICert)).String(),
+	}
+
+	list, err := c.k8sClient.K8sClient().CoreV1().Secrets(certs.SecretNamespace).List(ctx, o)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	if len(list.Items) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("%s/%s", list.Items[0].UID, list.Items[0].ResourceVersion), nil
+}
+
+func (c *TenantClient) newK8sClient(ctx context.Context, clusterID string, endpoint string) (k8sclient.Interface, error) {
+	var err error
+
 	var restConfig *rest.Config
 	{
-		restConfig, err = c.tenantCluster.NewRestConfig(ctx, key.ClusterID(cr), key.APIEndpoint(cr, bd))
+		restConfig, err = c.tenantCluster.NewRestConfig(ctx, clusterID, endpoint)
 		if tenantcluster.IsTimeout(err) {
 			return nil, microerror.Mask(notAvailableError)
 
@@ -74,11 +338,23 @@ func (c *TenantClient) K8sClient(ctx context.Context, obj interface{}) (k8sclien
 		}
 	}
 
+	// Requests made with the pooled client are reported to the circuit breaker
+	// of the tenant cluster, so that failing tenant APIs are detected by the
+	// requests controllers make anyway.
+	restConfig = rest.CopyConfig(restConfig)
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &observingTransport{
+			clusterID:    clusterID,
+			tenantClient: c,
+			transport:    rt,
+		}
+	})
+
 	var k8sClient k8sclient.Interface
 	{
 		c := k8sclient.ClientsConfig{
 			Logger:     c.logger,
-			RestConfig: rest.CopyConfig(restConfig),
+			RestConfig: restConfig,
 		}
 
 		k8sClient, err = k8sclient.NewClients(c)
@@ -89,3 +365,52 @@ func (c *TenantClient) K8sClient(ctx context.Context, obj interface{}) (k8sclien
 
 	return k8sClient, nil
 }
+
+// observe records the result of a request against the tenant API of the
+// given tenant cluster in its circuit breaker.
+func (c *TenantClient) observe(clusterID string, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	b, ok := c.breakers[clusterID]
+	if !ok && err == nil {
+		return
+	} else if !ok {
+		b = &breaker{state: BreakerClosed}
+	}
+
+	wasOpen := b.state == BreakerOpen
+	b.observe(err, c.failureThreshold, time.Now())
+
+	if b.state == BreakerClosed && b.failures == 0 {
+		delete(c.breakers, clusterID)
+	} else {
+		c.breakers[clusterID] = b
+	}
+
+	if !wasOpen && b.state == BreakerOpen {
+		c.logger.Debugf(context.Background(), "opened circuit breaker of tenant cluster %#q after %d consecutive failures", clusterID, b.failures)
+	}
+}
+
+// releaseProbe lets the next request probe the tenant API of the given tenant
+// cluster in case the current request was meant to probe it, but failed
+// before reaching the tenant API.
+func (c *TenantClient) releaseProbe(clusterID string, probe bool, err error) {
+	if probe {
+		c.observe(clusterID, err)
+	}
+}
+
+// observeBuild records the given build duration. The caller must hold the
+// mutex.
+func (c *TenantClient) observeBuild(d time.Duration) {
+	c.stats.BuildCount++
+	c.stats.BuildSum += d.Seconds()
+
+	for _, b := range buildBuckets {
+		if d.Seconds() <= b {
+			c.stats.BuildBuckets[b]++
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package tenantclient
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_TenantClient_observeBuild(t *testing.T) {
+	testCases := []struct {
+		name          string
+		durations     []time.Duration
+		expectedStats PoolStats
+	}{
+		{
+			name: "case 0: no builds",
+			expectedStats: PoolStats{
+				BuildBuckets: map[float64]uint64{},
+				Breakers:     map[string]BreakerState{},
+			},
+		},
+		{
+			name: "case 1: single fast build",
+			durations: []time.Duration{
+				50 * time.Millisecond,
+			},
+			expectedStats: PoolStats{
+				BuildCount: 1,
+				BuildSum:   0.05,
+				BuildBuckets: map[float64]uint64{
+					0.1: 1,
+					0.5: 1,
+					1:   1,
+					2.5: 1,
+					5:   1,
+					10:  1,
+					30:  1,
+				},
+				Breakers: map[string]BreakerState{},
+			},
+		},
+		{
+			name: "case 2: builds spread over buckets",
+			durations: []time.Duration{
+				2 * time.Second,
+				12 * time.Second,
+				time.Minute,
+			},
+			expectedStats: PoolStats{
+				BuildCount: 3,
+				BuildSum:   74,
+				BuildBuckets: map[float64]uint64{
+					2.5: 1,
+					5:   1,
+					10:  1,
+					30:  2,
+				},
+				Breakers: map[string]BreakerState{},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c := &TenantClient{
+				clients: map[string]pooledClient{},
+				stats: PoolStats{
+					BuildBuckets: map[float64]uint64{},
+				},
+			}
+
+			for _, d := range tc.durations {
+				c.observeBuild(d)
+			}
+
+			stats := c.Stats()
+			if !reflect.DeepEqual(stats, tc.expectedStats) {
+				t.Fatalf("expected %#v to be equal to %#v", tc.expectedStats, stats)
+			}
+		})
+	}
+}
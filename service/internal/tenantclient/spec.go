@@ -2,6 +2,7 @@ package tenantclient
 
 import (
 	"context"
+	"time"
 
 	client "github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 )
@@ -9,4 +10,61 @@ import (
 type Interface interface {
 	// K8sClient returns client interface of the corresponding cluster object
 	K8sClient(ctx context.Context, obj interface{}) (client.Interface, error)
+	// Evict removes the pooled client of the tenant cluster the given object
+	// belongs to, e.g. when the tenant cluster is deleted.
+	Evict(ctx context.Context, obj interface{}) error
+	// Breaker returns the status of the circuit breaker of the tenant cluster
+	// the given object belongs to.
+	Breaker(ctx context.Context, obj interface{}) (BreakerStatus, error)
+	// Stats returns the current statistics of the tenant client pool.
+	Stats() PoolStats
+	// Version returns the version of the tenant client of the tenant cluster
+	// the given object belongs to. The version changes with the tenant API
+	// endpoint and the cluster-operator API cert of the tenant cluster, but not
+	// when pooled clients are built again after their TTL expired.
+	Version(ctx context.Context, obj interface{}) (string, error)
+}
+
+type BreakerState string
+
+const (
+	// BreakerClosed is the state of circuit breakers letting requests against
+	// the tenant API through.
+	BreakerClosed BreakerState = "closed"
+	// BreakerHalfOpen is the state of circuit breakers letting a single
+	// request against the tenant API through after the cooldown period.
+	BreakerHalfOpen BreakerState = "half-open"
+	// BreakerOpen is the state of circuit breakers short-circuiting requests
+	// against the tenant API.
+	BreakerOpen BreakerState = "open"
+)
+
+// BreakerStatus is the status of the circuit breaker of a tenant cluster.
+type BreakerStatus struct {
+	// Failures is the number of consecutive failed requests against the tenant
+	// API.
+	Failures int
+	// Since is the time the breaker transitioned into its current state. It is
+	// zero for breakers which never opened.
+	Since time.Time
+	// State is the current state of the breaker.
+	State BreakerState
+}
+
+// PoolStats holds the statistics of the tenant client pool.
+type PoolStats struct {
+	// Size is the number of pooled tenant clients.
+	Size int
+	// BuildCount is the number of tenant clients built.
+	BuildCount uint64
+	// BuildSum is the total number of seconds building tenant clients took.
+	BuildSum float64
+	// BuildBuckets is a map of key value pairs where the key is the upper bound
+	// in seconds of a histogram bucket. The map value is the cumulative number
+	// of tenant clients built within that time.
+	BuildBuckets map[float64]uint64
+	// Breakers is a map of key value pairs where the key is the cluster ID of
+	// pooled tenant clients and tenant clusters with failing tenant APIs. The
+	// map value is the state of the circuit breaker of the tenant cluster.
+	Breakers map[string]BreakerState
 }
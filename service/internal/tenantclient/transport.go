@@ -0,0 +1,47 @@
+package tenantclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/giantswarm/microerror"
+)
+
+// observingTransport reports the result of every request made with a pooled
+// tenant client to the circuit breaker of its tenant cluster. Requests
+// canceled by their callers are not reported, since they do not tell anything
+// about the tenant API.
+type observingTransport struct {
+	clusterID    string
+	tenantClient *TenantClient
+	transport    http.RoundTripper
+}
+
+func (t *observingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.transport.RoundTrip(req)
+	if errors.Is(req.Context().Err(), context.Canceled) {
+		return res, err
+	}
+
+	t.tenantClient.observe(t.clusterID, requestFailure(res, err))
+
+	return res, err
+}
+
+// requestFailure returns a notAvailableError in case the given request result
+// indicates that the tenant API is unavailable, which is the case for
+// transport errors and responses of unavailable API servers or the load
+// balancers in front of them.
+func requestFailure(res *http.Response, err error) error {
+	if err != nil {
+		return microerror.Maskf(notAvailableError, err.Error())
+	}
+
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return microerror.Maskf(notAvailableError, "tenant API responded with status code %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package tenantclient
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func Test_requestFailure(t *testing.T) {
+	testCases := []struct {
+		name                string
+		res                 *http.Response
+		err                 error
+		expectedUnavailable bool
+	}{
+		{
+			name:                "case 0: successful response",
+			res:                 &http.Response{StatusCode: http.StatusOK},
+			expectedUnavailable: false,
+		},
+		{
+			name:                "case 1: transport error",
+			err:                 errors.New("connection refused"),
+			expectedUnavailable: true,
+		},
+		{
+			name:                "case 2: service unavailable response",
+			res:                 &http.Response{StatusCode: http.StatusServiceUnavailable},
+			expectedUnavailable: true,
+		},
+		{
+			name:                "case 3: gateway timeout response",
+			res:                 &http.Response{StatusCode: http.StatusGatewayTimeout},
+			expectedUnavailable: true,
+		},
+		{
+			name:                "case 4: forbidden response",
+			res:                 &http.Response{StatusCode: http.StatusForbidden},
+			expectedUnavailable: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			err := requestFailure(tc.res, tc.err)
+
+			unavailable := err != nil && isTenantAPIFailure(err)
+			if unavailable != tc.expectedUnavailable {
+				t.Fatalf("expected %t to be equal to %t", tc.expectedUnavailable, unavailable)
+			}
+		})
+	}
+}
@@ -25,3 +25,19 @@ func FakeTenantClient(k8sclient k8sclient.Interface) tenantclient.Interface {
 func (f *fakeTenantClient) K8sClient(ctx context.Context, obj interface{}) (k8sclient.Interface, error) {
 	return f.k8sClient, nil
 }
+
+func (f *fakeTenantClient) Evict(ctx context.Context, obj interface{}) error {
+	return nil
+}
+
+func (f *fakeTenantClient) Breaker(ctx context.Context, obj interface{}) (tenantclient.BreakerStatus, error) {
+	return tenantclient.BreakerStatus{State: tenantclient.BreakerClosed}, nil
+}
+
+func (f *fakeTenantClient) Stats() tenantclient.PoolStats {
+	return tenantclient.PoolStats{}
+}
+
+func (f *fakeTenantClient) Version(ctx context.Context, obj interface{}) (string, error) {
+	return "", nil
+}
@@ -0,0 +1,18 @@
+package tenantinformer
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+type Interface interface {
+	// Nodes returns the nodes of the tenant cluster the given object belongs to.
+	// The nodes are served from a shared Node informer per tenant cluster, which
+	// is started lazily on first use. Until the informer is synced the nodes are
+	// listed from the tenant API directly.
+	Nodes(ctx context.Context, obj interface{}) ([]corev1.Node, error)
+	// Stop stops the Node informer of the tenant cluster the given object
+	// belongs to, e.g. when the tenant cluster is deleted.
+	Stop(ctx context.Context, obj interface{}) error
+}
@@ -0,0 +1,426 @@
+package tenantinformer
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/pkg/label"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
+)
+
+const (
+	// enqueueDelay is the duration node changes are collected for before the
+	// owning CR is enqueued, so that e.g. a rolling node pool update does not
+	// enqueue the MachineDeployment CR for every single node.
+	enqueueDelay = 5 * time.Second
+	// probeFailureThreshold is the number of consecutive failed probes of the
+	// tenant API after which the tenant cluster is considered unreachable.
+	probeFailureThreshold = 3
+	// probeInterval is the interval in which the tenant API of running
+	// informers is probed.
+	probeInterval = 1 * time.Minute
+	// resyncPeriod is the resync period of the Node informers. Resyncs do not
+	// enqueue owners, because the nodes did not change.
+	resyncPeriod = 30 * time.Minute
+)
+
+type Config struct {
+	K8sClient    k8sclient.Interface
+	Logger       micrologger.Logger
+	TenantClient tenantclient.Interface
+}
+
+// TenantInformer manages a shared Node informer per tenant cluster. Node
+// changes relevant for the status of node pools and control planes enqueue the
+// owning MachineDeployment and G8sControlPlane CRs for reconciliation by
+// setting the nodes-changed annotation on them. Informers of tenant clusters
+// being unreachable are stopped and started again on next use.
+type TenantInformer struct {
+	k8sClient    k8sclient.Interface
+	logger       micrologger.Logger
+	tenantClient tenantclient.Interface
+
+	mutex     sync.Mutex
+	informers map[string]*nodeInformer
+	pending   map[string]struct{}
+}
+
+type nodeInformer struct {
+	clusterID string
+	namespace string
+	informer  cache.SharedIndexInformer
+	k8sClient k8sclient.Interface
+	// started is the time the informer got started. Nodes created before
+	// were not added, but listed initially.
+	started  time.Time
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	// version is the version of the tenant client the informer got started
+	// with.
+	version string
+}
+
+func New(c Config) (*TenantInformer, error) {
+	if c.K8sClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", c)
+	}
+	if c.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", c)
+	}
+	if c.TenantClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.TenantClient must not be empty", c)
+	}
+
+	t := &TenantInformer{
+		k8sClient:    c.K8sClient,
+		logger:       c.Logger,
+		tenantClient: c.TenantClient,
+
+		informers: map[string]*nodeInformer{},
+		pending:   map[string]struct{}{},
+	}
+
+	return t, nil
+}
+
+func (t *TenantInformer) Nodes(ctx context.Context, obj interface{}) ([]corev1.Node, error) {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	ni, err := t.nodeInformer(ctx, cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	if !ni.informer.HasSynced() {
+		list, err := ni.k8sClient.K8sClient().CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+
+		return list.Items, nil
+	}
+
+	var nodes []corev1.Node
+	for _, o := range ni.informer.GetStore().List() {
+		n, ok := o.(*corev1.Node)
+		if !ok {
+			continue
+		}
+		nodes = append(nodes, *n.DeepCopy())
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	return nodes, nil
+}
+
+func (t *TenantInformer) Stop(ctx context.Context, obj interface{}) error {
+	cr, err := meta.Accessor(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	t.mutex.Lock()
+	ni, ok := t.informers[key.ClusterID(cr)]
+	t.mutex.Unlock()
+
+	if ok {
+		t.logger.Debugf(ctx, "stopping node informer of tenant cluster %#q", key.ClusterID(cr))
+		t.remove(ni)
+		t.logger.Debugf(ctx, "stopped node informer of tenant cluster %#q", key.ClusterID(cr))
+	}
+
+	return nil
+}
+
+func (t *TenantInformer) nodeInformer(ctx context.Context, cr metav1.Object) (*nodeInformer, error) {
+	id := key.ClusterID(cr)
+
+	// The running informer is reused as long as the version of the tenant
+	// client does not change, so that pooled clients being built again after
+	// their TTL expired do not cause all nodes to be listed again. Getting
+	// the tenant client might take a while, e.g. when certificates are not
+	// yet issued, which is why the lock is not held meanwhile. In case another
+	// informer got started concurrently, it is used instead.
+	k8sClient, err := t.tenantClient.K8sClient(ctx, cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	version, err := t.tenantClient.Version(ctx, cr)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	t.mutex.Lock()
+	ni, ok := t.informers[id]
+	t.mutex.Unlock()
+	if ok && ni.version == version {
+		return ni, nil
+	} else if ok {
+		t.logger.Debugf(ctx, "stopping node informer of tenant cluster %#q", id)
+		t.remove(ni)
+		t.logger.Debugf(ctx, "stopped node informer of tenant cluster %#q", id)
+	}
+
+	ni = &nodeInformer{
+		clusterID: id,
+		namespace: cr.GetNamespace(),
+		informer:  coreinformers.NewNodeInformer(k8sClient.K8sClient(), resyncPeriod, cache.Indexers{}),
+		k8sClient: k8sClient,
+		started:   time.Now().Truncate(time.Second),
+		stopCh:    make(chan struct{}),
+		version:   version,
+	}
+
+	t.mutex.Lock()
+	existing, ok := t.informers[id]
+	if ok && existing.version == version {
+		t.mutex.Unlock()
+		return existing, nil
+	}
+	t.informers[id] = ni
+	t.mutex.Unlock()
+
+	if ok {
+		existing.stop()
+	}
+
+	ni.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		// The initial list of the informer adds all existing nodes, which
+		// must not enqueue their owners on every start of the informer.
+		AddFunc: func(obj interface{}) {
+			n, ok := obj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			if nodeAdded(n, ni.started) {
+				t.enqueueOwner(ni, n)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			o, ok := oldObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			n, ok := newObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			if nodeChanged(o, n) {
+				t.enqueueOwner(ni, n)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = d.Obj
+			}
+			t.enqueueOwner(ni, obj)
+		},
+	})
+
+	t.logger.Debugf(ctx, "starting node informer of tenant cluster %#q", id)
+
+	go ni.informer.Run(ni.stopCh)
+	go t.probe(ni)
+
+	return ni, nil
+}
+
+// enqueueOwner schedules the MachineDeployment or G8sControlPlane CR owning the
+// given node to be enqueued once the enqueue delay passed. Further changes of
+// nodes with the same owner are collected meanwhile.
+func (t *TenantInformer) enqueueOwner(ni *nodeInformer, obj interface{}) {
+	n, ok := obj.(*corev1.Node)
+	if !ok {
+		return
+	}
+
+	var ownerLabel string
+	if _, ok := n.Labels[label.MasterNodeRole]; ok {
+		ownerLabel = label.ControlPlane
+	} else if _, ok := n.Labels[label.WorkerNodeRole]; ok {
+		ownerLabel = label.MachineDeployment
+	} else {
+		return
+	}
+
+	ownerID := n.Labels[ownerLabel]
+	if ownerID == "" {
+		return
+	}
+
+	k := fmt.Sprintf("%s/%s/%s", ni.clusterID, ownerLabel, ownerID)
+
+	t.mutex.Lock()
+	_, ok = t.pending[k]
+	if !ok {
+		t.pending[k] = struct{}{}
+	}
+	t.mutex.Unlock()
+
+	if ok {
+		return
+	}
+
+	time.AfterFunc(enqueueDelay, func() {
+		t.mutex.Lock()
+		delete(t.pending, k)
+		t.mutex.Unlock()
+
+		ctx := context.Background()
+
+		err := t.enqueue(ctx, ni, ownerLabel, ownerID)
+		if err != nil {
+			t.logger.Errorf(ctx, err, "failed to enqueue owner %#q of nodes of tenant cluster %#q", ownerID, ni.clusterID)
+		}
+	})
+}
+
+func (t *TenantInformer) enqueue(ctx context.Context, ni *nodeInformer, ownerLabel string, ownerID string) error {
+	var owners []runtime.Object
+	{
+		opts := []client.ListOption{
+			client.InNamespace(ni.namespace),
+			client.MatchingLabels{
+				label.Cluster: ni.clusterID,
+				ownerLabel:    ownerID,
+			},
+		}
+
+		switch ownerLabel {
+		case label.ControlPlane:
+			var list infrastructurev1alpha3.G8sControlPlaneList
+			err := t.k8sClient.CtrlClient().List(ctx, &list, opts...)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			for i := range list.Items {
+				owners = append(owners, &list.Items[i])
+			}
+		case label.MachineDeployment:
+			var list apiv1alpha3.MachineDeploymentList
+			err := t.k8sClient.CtrlClient().List(ctx, &list, opts...)
+			if err != nil {
+				return microerror.Mask(err)
+			}
+			for i := range list.Items {
+				owners = append(owners, &list.Items[i])
+			}
+		}
+	}
+
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, annotation.NodesChanged, time.Now().UTC().Format(time.RFC3339)))
+
+	for _, o := range owners {
+		err := t.k8sClient.CtrlClient().Patch(ctx, o, client.RawPatch(types.MergePatchType, patch))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+	}
+
+	return nil
+}
+
+// probe checks the tenant API of the given informer periodically and stops the
+// informer once the tenant cluster is considered unreachable. Probe results
+// are reported to the circuit breaker of the tenant cluster by the transport
+// of the pooled tenant client.
+func (t *TenantInformer) probe(ni *nodeInformer) {
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+
+	var failures int
+	for {
+		select {
+		case <-ni.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		_, err := ni.k8sClient.K8sClient().Discovery().ServerVersion()
+		if err == nil {
+			failures = 0
+			continue
+		}
+
+		failures++
+		if failures >= probeFailureThreshold {
+			t.logger.Debugf(context.Background(), "stopping node informer of unreachable tenant cluster %#q", ni.clusterID)
+			t.remove(ni)
+			return
+		}
+	}
+}
+
+func (t *TenantInformer) remove(ni *nodeInformer) {
+	t.mutex.Lock()
+	if t.informers[ni.clusterID] == ni {
+		delete(t.informers, ni.clusterID)
+	}
+	t.mutex.Unlock()
+
+	ni.stop()
+}
+
+func (ni *nodeInformer) stop() {
+	ni.stopOnce.Do(func() {
+		close(ni.stopCh)
+	})
+}
+
+// nodeAdded returns true when the given node got created after the informer
+// started at the given time, as opposed to nodes added by the initial list of
+// the informer.
+func nodeAdded(n *corev1.Node, started time.Time) bool {
+	return !n.GetCreationTimestamp().Time.Before(started)
+}
+
+// nodeChanged returns true when the given node changed in a way affecting the
+// status of its node pool or control plane. Heartbeats of the kubelet are
+// ignored.
+func nodeChanged(old *corev1.Node, new *corev1.Node) bool {
+	if !reflect.DeepEqual(old.Labels, new.Labels) {
+		return true
+	}
+	if old.Spec.Unschedulable != new.Spec.Unschedulable {
+		return true
+	}
+
+	return nodeReady(old) != nodeReady(new)
+}
+
+func nodeReady(n *corev1.Node) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
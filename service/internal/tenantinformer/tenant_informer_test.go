@@ -0,0 +1,113 @@
+package tenantinformer
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_nodeAdded(t *testing.T) {
+	started := time.Now()
+
+	testCases := []struct {
+		name     string
+		created  time.Time
+		expected bool
+	}{
+		{
+			name:     "case 0: node listed initially",
+			created:  started.Add(-24 * time.Hour),
+			expected: false,
+		},
+		{
+			name:     "case 1: node created after the informer started",
+			created:  started.Add(5 * time.Minute),
+			expected: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			n := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(tc.created),
+				},
+			}
+
+			added := nodeAdded(n, started)
+
+			if added != tc.expected {
+				t.Fatalf("expected %t to be equal to %t", tc.expected, added)
+			}
+		})
+	}
+}
+
+func Test_nodeChanged(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      *corev1.Node
+		new      *corev1.Node
+		expected bool
+	}{
+		{
+			name:     "case 0: kubelet heartbeat",
+			old:      newNode("8.7.5", false, corev1.ConditionTrue),
+			new:      newNode("8.7.5", false, corev1.ConditionTrue),
+			expected: false,
+		},
+		{
+			name:     "case 1: node got ready",
+			old:      newNode("8.7.5", false, corev1.ConditionFalse),
+			new:      newNode("8.7.5", false, corev1.ConditionTrue),
+			expected: true,
+		},
+		{
+			name:     "case 2: node got relabelled",
+			old:      newNode("8.7.5", false, corev1.ConditionTrue),
+			new:      newNode("8.7.6", false, corev1.ConditionTrue),
+			expected: true,
+		},
+		{
+			name:     "case 3: node got cordoned",
+			old:      newNode("8.7.5", false, corev1.ConditionTrue),
+			new:      newNode("8.7.5", true, corev1.ConditionTrue),
+			expected: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			changed := nodeChanged(tc.old, tc.new)
+
+			if changed != tc.expected {
+				t.Fatalf("expected %t to be equal to %t", tc.expected, changed)
+			}
+		})
+	}
+}
+
+func newNode(version string, unschedulable bool, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"aws-operator.giantswarm.io/version": version,
+			},
+		},
+		Spec: corev1.NodeSpec{
+			Unschedulable: unschedulable,
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{
+					Type:              corev1.NodeReady,
+					Status:            ready,
+					LastHeartbeatTime: metav1.Now(),
+				},
+			},
+		},
+	}
+}
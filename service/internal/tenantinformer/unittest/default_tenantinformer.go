@@ -0,0 +1,41 @@
+package unittest
+
+import (
+	"context"
+
+	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantinformer"
+)
+
+type fakeTenantInformer struct {
+	k8sClient k8sclient.Interface
+}
+
+// FakeTenantInformer returns a tenant informer listing the nodes of the given
+// client on every call instead of running an informer.
+func FakeTenantInformer(k8sclient k8sclient.Interface) tenantinformer.Interface {
+	var tenantInformer tenantinformer.Interface
+	{
+		tenantInformer = &fakeTenantInformer{
+			k8sClient: k8sclient,
+		}
+	}
+
+	return tenantInformer
+}
+
+func (f *fakeTenantInformer) Nodes(ctx context.Context, obj interface{}) ([]corev1.Node, error) {
+	list, err := f.k8sClient.K8sClient().CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+func (f *fakeTenantInformer) Stop(ctx context.Context, obj interface{}) error {
+	return nil
+}
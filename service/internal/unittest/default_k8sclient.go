@@ -4,6 +4,7 @@ import (
 	infrastructurev1alpha3 "github.com/giantswarm/apiextensions/v3/pkg/apis/infrastructure/v1alpha3"
 	releasev1alpha1 "github.com/giantswarm/apiextensions/v3/pkg/apis/release/v1alpha1"
 	"github.com/giantswarm/apiextensions/v3/pkg/clientset/versioned"
+	fakeg8s "github.com/giantswarm/apiextensions/v3/pkg/clientset/versioned/fake"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8sclient"
 	"github.com/giantswarm/k8sclient/v5/pkg/k8scrdclient"
 	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
@@ -12,12 +13,14 @@ import (
 	"k8s.io/client-go/kubernetes"
 	fakek8s "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
+	apiv1alpha3 "sigs.k8s.io/cluster-api/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake" //nolint:staticcheck // v0.6.4 has a deprecation on pkg/client/fake that was removed in later versions
 )
 
 type fakeK8sClient struct {
 	ctrlClient client.Client
+	g8sClient  *fakeg8s.Clientset
 	k8sClient  *fakek8s.Clientset
 }
 
@@ -27,6 +30,10 @@ func FakeK8sClient() k8sclient.Interface {
 	var k8sClient k8sclient.Interface
 	{
 		scheme := runtime.NewScheme()
+		err = apiv1alpha3.AddToScheme(scheme)
+		if err != nil {
+			panic(err)
+		}
 		err = infrastructurev1alpha3.AddToScheme(scheme)
 		if err != nil {
 			panic(err)
@@ -38,6 +45,7 @@ func FakeK8sClient() k8sclient.Interface {
 
 		k8sClient = &fakeK8sClient{
 			ctrlClient: fake.NewFakeClientWithScheme(scheme),
+			g8sClient:  fakeg8s.NewSimpleClientset(),
 			k8sClient:  fakek8s.NewSimpleClientset(),
 		}
 	}
@@ -62,7 +70,7 @@ func (f *fakeK8sClient) ExtClient() apiextensionsclient.Interface {
 }
 
 func (f *fakeK8sClient) G8sClient() versioned.Interface {
-	return nil
+	return f.g8sClient
 }
 
 func (f *fakeK8sClient) K8sClient() kubernetes.Interface {
@@ -3,7 +3,6 @@ package service
 import (
 	"context"
 	"fmt"
-	"net"
 	"sync"
 	"time"
 
@@ -25,17 +24,20 @@ import (
 	"github.com/giantswarm/cluster-operator/v3/pkg/project"
 	"github.com/giantswarm/cluster-operator/v3/service/collector"
 	"github.com/giantswarm/cluster-operator/v3/service/controller"
-	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+	"github.com/giantswarm/cluster-operator/v3/service/deletionplan"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/apiendpoint"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/basedomain"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/clusternetwork"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/deletionprogress"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/nodecount"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/orphansweeper"
+	"github.com/giantswarm/cluster-operator/v3/service/internal/paused"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/podcidr"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/recorder"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/releaseversion"
 	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantclient"
-)
-
-const (
-	apiServerIPLastOctet = 1
+	"github.com/giantswarm/cluster-operator/v3/service/internal/tenantinformer"
+	"github.com/giantswarm/cluster-operator/v3/service/webhook"
 )
 
 // Config represents the configuration used to create a new service.
@@ -54,13 +56,16 @@ type Config struct {
 
 // Service is a type providing implementation of microkit service interface.
 type Service struct {
-	Version *version.Service
+	DeletionPlan *deletionplan.DeletionPlan
+	Version      *version.Service
 
 	bootOnce                    sync.Once
 	clusterController           *controller.Cluster
 	controlPlaneController      *controller.ControlPlane
 	machineDeploymentController *controller.MachineDeployment
 	operatorCollector           *collector.Set
+	orphanSweeper               *orphansweeper.Sweeper
+	webhook                     *webhook.Webhook
 }
 
 // New creates a new service with given configuration.
@@ -76,7 +81,6 @@ func New(config Config) (*Service, error) {
 
 	calicoSubnet := config.Viper.GetString(config.Flag.Guest.Cluster.Calico.Subnet)
 	calicoCIDR := config.Viper.GetString(config.Flag.Guest.Cluster.Calico.CIDR)
-	clusterIPRange := config.Viper.GetString(config.Flag.Guest.Cluster.Kubernetes.API.ClusterIPRange)
 	provider := config.Viper.GetString(config.Flag.Service.Provider.Kind)
 	registryDomain := config.Viper.GetString(config.Flag.Service.Image.Registry.Domain)
 
@@ -120,23 +124,6 @@ func New(config Config) (*Service, error) {
 		}
 	}
 
-	var dnsIP string
-	{
-		dnsIP, err = key.DNSIP(clusterIPRange)
-		if err != nil {
-			return nil, microerror.Mask(err)
-		}
-	}
-
-	var apiIP string
-	{
-		_, ip, err := parseClusterIPRange(config.Viper.GetString(config.Flag.Guest.Cluster.Kubernetes.API.ClusterIPRange))
-		if err != nil {
-			return nil, microerror.Mask(err)
-		}
-		apiIP = ip.String()
-	}
-
 	var certsSearcher certs.Interface
 	{
 		c := certs.Config{
@@ -172,7 +159,10 @@ func New(config Config) (*Service, error) {
 		c := podcidr.Config{
 			K8sClient: k8sClient,
 
-			InstallationCIDR: fmt.Sprintf("%s/%s", calicoSubnet, calicoCIDR),
+			InstallationCIDR:        fmt.Sprintf("%s/%s", calicoSubnet, calicoCIDR),
+			InstallationServiceCIDR: config.Viper.GetString(config.Flag.Guest.Cluster.Kubernetes.API.ClusterIPRange),
+			Pool:                    config.Viper.GetString(config.Flag.Guest.Cluster.Calico.Pool.CIDR),
+			PoolPrefixLength:        config.Viper.GetInt(config.Flag.Guest.Cluster.Calico.Pool.PrefixLength),
 		}
 
 		pc, err = podcidr.New(c)
@@ -181,6 +171,22 @@ func New(config Config) (*Service, error) {
 		}
 	}
 
+	var cn clusternetwork.Interface
+	{
+		c := clusternetwork.Config{
+			K8sClient: k8sClient,
+			PodCIDR:   pc,
+
+			InstallationClusterDomain:  config.Viper.GetString(config.Flag.Guest.Cluster.Kubernetes.ClusterDomain),
+			InstallationClusterIPRange: config.Viper.GetString(config.Flag.Guest.Cluster.Kubernetes.API.ClusterIPRange),
+		}
+
+		cn, err = clusternetwork.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var bd basedomain.Interface
 	{
 		c := basedomain.Config{
@@ -193,13 +199,30 @@ func New(config Config) (*Service, error) {
 		}
 	}
 
+	var ae apiendpoint.Interface
+	{
+		c := apiendpoint.Config{
+			BaseDomain: bd,
+			K8sClient:  k8sClient,
+		}
+
+		ae, err = apiendpoint.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var tenantClient tenantclient.Interface
 	{
 		c := tenantclient.Config{
 			K8sClient:     k8sClient,
-			BaseDomain:    bd,
+			APIEndpoint:   ae,
 			TenantCluster: tenantCluster,
 			Logger:        config.Logger,
+
+			Cooldown:         config.Viper.GetDuration(config.Flag.Service.Cluster.TenantAPICooldown),
+			FailureThreshold: config.Viper.GetInt(config.Flag.Service.Cluster.TenantAPIFailureThreshold),
+			TTL:              config.Viper.GetDuration(config.Flag.Service.Cluster.TenantClientTTL),
 		}
 
 		tenantClient, err = tenantclient.New(c)
@@ -208,13 +231,29 @@ func New(config Config) (*Service, error) {
 		}
 	}
 
-	var nc nodecount.Interface
+	var tenantInformer tenantinformer.Interface
 	{
-		c := nodecount.Config{
+		c := tenantinformer.Config{
 			K8sClient:    k8sClient,
+			Logger:       config.Logger,
 			TenantClient: tenantClient,
 		}
 
+		tenantInformer, err = tenantinformer.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var nc nodecount.Interface
+	{
+		c := nodecount.Config{
+			K8sClient:      k8sClient,
+			TenantInformer: tenantInformer,
+
+			Provider: provider,
+		}
+
 		nc, err = nodecount.New(c)
 		if err != nil {
 			return nil, microerror.Mask(err)
@@ -225,6 +264,9 @@ func New(config Config) (*Service, error) {
 	{
 		c := releaseversion.Config{
 			K8sClient: k8sClient,
+
+			CreationTimeout: config.Viper.GetDuration(config.Flag.Service.Cluster.CreationTimeout),
+			UpdateTimeout:   config.Viper.GetDuration(config.Flag.Service.Cluster.UpdateTimeout),
 		}
 
 		rv, err = releaseversion.New(c)
@@ -244,24 +286,56 @@ func New(config Config) (*Service, error) {
 		eventRecorder = recorder.New(c)
 	}
 
+	var deletionProgress deletionprogress.Interface
+	{
+		c := deletionprogress.Config{
+			K8sClient: k8sClient,
+			Logger:    config.Logger,
+		}
+
+		deletionProgress, err = deletionprogress.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var p paused.Interface
+	{
+		c := paused.Config{
+			K8sClient: k8sClient,
+		}
+
+		p, err = paused.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var clusterController *controller.Cluster
 	{
 		c := controller.ClusterConfig{
-			BaseDomain:     bd,
-			CertsSearcher:  certsSearcher,
-			Event:          eventRecorder,
-			FileSystem:     afero.NewOsFs(),
-			K8sClient:      k8sClient,
-			Logger:         config.Logger,
-			PodCIDR:        pc,
-			Tenant:         tenantCluster,
-			ReleaseVersion: rv,
+			APIEndpoint:      ae,
+			BaseDomain:       bd,
+			CertsSearcher:    certsSearcher,
+			ClusterNetwork:   cn,
+			DeletionProgress: deletionProgress,
+			Event:            eventRecorder,
+			FileSystem:       afero.NewOsFs(),
+			K8sClient:        k8sClient,
+			Logger:           config.Logger,
+			Paused:           p,
+			PodCIDR:          pc,
+			Tenant:           tenantCluster,
+			TenantClient:     tenantClient,
+			TenantInformer:   tenantInformer,
+			ReleaseVersion:   rv,
 
-			APIIP:                      apiIP,
 			CertTTL:                    config.Viper.GetString(config.Flag.Guest.Cluster.Vault.Certificate.TTL),
-			ClusterIPRange:             clusterIPRange,
-			DNSIP:                      dnsIP,
-			ClusterDomain:              config.Viper.GetString(config.Flag.Guest.Cluster.Kubernetes.ClusterDomain),
+			DegradedThreshold:          config.Viper.GetDuration(config.Flag.Service.Cluster.DegradedThreshold),
+			DeletionTimeout:            config.Viper.GetDuration(config.Flag.Service.Cluster.DeletionTimeout),
+			PausedStatusCollection:     config.Viper.GetBool(config.Flag.Service.Cluster.PausedStatusCollection),
+			StatusHistoryArchive:       config.Viper.GetBool(config.Flag.Service.Cluster.StatusHistoryArchive),
+			StatusHistoryLimit:         config.Viper.GetInt(config.Flag.Service.Cluster.StatusHistoryLimit),
 			KiamWatchDogEnabled:        config.Viper.GetBool(config.Flag.Service.Release.App.Config.KiamWatchDogEnabled),
 			NewCommonClusterObjectFunc: newCommonClusterObjectFunc(provider),
 			Provider:                   provider,
@@ -284,10 +358,13 @@ func New(config Config) (*Service, error) {
 			K8sClient:      k8sClient,
 			Logger:         config.Logger,
 			NodeCount:      nc,
+			Paused:         p,
 			Tenant:         tenantCluster,
 			ReleaseVersion: rv,
 
-			Provider: provider,
+			PausedStatusCollection:     config.Viper.GetBool(config.Flag.Service.Cluster.PausedStatusCollection),
+			NewCommonClusterObjectFunc: newCommonClusterObjectFunc(provider),
+			Provider:                   provider,
 		}
 
 		controlPlaneController, err = controller.NewControlPlane(c)
@@ -304,10 +381,15 @@ func New(config Config) (*Service, error) {
 			K8sClient:      k8sClient,
 			Logger:         config.Logger,
 			NodeCount:      nc,
+			Paused:         p,
 			Tenant:         tenantCluster,
+			TenantClient:   tenantClient,
 			ReleaseVersion: rv,
 
-			Provider: provider,
+			DrainTimeout:               config.Viper.GetDuration(config.Flag.Service.Cluster.NodePoolDrainTimeout),
+			PausedStatusCollection:     config.Viper.GetBool(config.Flag.Service.Cluster.PausedStatusCollection),
+			NewCommonClusterObjectFunc: newCommonClusterObjectFunc(provider),
+			Provider:                   provider,
 		}
 
 		machineDeploymentController, err = controller.NewMachineDeployment(c)
@@ -316,14 +398,38 @@ func New(config Config) (*Service, error) {
 		}
 	}
 
+	var orphanSweeper *orphansweeper.Sweeper
+	{
+		c := orphansweeper.Config{
+			K8sClient: k8sClient,
+			Logger:    config.Logger,
+
+			Delete:      config.Viper.GetBool(config.Flag.Service.Orphan.Delete),
+			GracePeriod: config.Viper.GetDuration(config.Flag.Service.Orphan.GracePeriod),
+			Interval:    config.Viper.GetDuration(config.Flag.Service.Orphan.Interval),
+		}
+
+		orphanSweeper, err = orphansweeper.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var operatorCollector *collector.Set
 	{
 		c := collector.SetConfig{
-			CertSearcher: certsSearcher,
-			K8sClient:    k8sClient,
-			Logger:       config.Logger,
+			CertSearcher:   certsSearcher,
+			K8sClient:      k8sClient,
+			Logger:         config.Logger,
+			OrphanSweeper:  orphanSweeper,
+			PodCIDR:        pc,
+			ReleaseVersion: rv,
+			TenantClient:   tenantClient,
 
-			NewCommonClusterObjectFunc: newCommonClusterObjectFunc(provider),
+			KubeConfigCertExpiryThreshold: config.Viper.GetDuration(config.Flag.Service.KubeConfig.CertExpiryThreshold),
+			NewCommonClusterObjectFunc:    newCommonClusterObjectFunc(provider),
+			Provider:                      provider,
+			ReleaseMaxAge:                 config.Viper.GetDuration(config.Flag.Service.Release.MaxAge),
 		}
 
 		operatorCollector, err = collector.NewSet(c)
@@ -332,6 +438,38 @@ func New(config Config) (*Service, error) {
 		}
 	}
 
+	// The admission webhook is optional, since it requires a TLS certificate
+	// trusted by the Kubernetes API.
+	var webhookServer *webhook.Webhook
+	if config.Viper.GetBool(config.Flag.Service.Webhook.Enabled) {
+		c := webhook.Config{
+			Logger: config.Logger,
+
+			Address: config.Viper.GetString(config.Flag.Service.Webhook.Address),
+			CrtFile: config.Viper.GetString(config.Flag.Service.Webhook.CrtFile),
+			KeyFile: config.Viper.GetString(config.Flag.Service.Webhook.KeyFile),
+		}
+
+		webhookServer, err = webhook.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	var deletionPlan *deletionplan.DeletionPlan
+	{
+		c := deletionplan.Config{
+			DeletionProgress: deletionProgress,
+			K8sClient:        k8sClient,
+			Logger:           config.Logger,
+		}
+
+		deletionPlan, err = deletionplan.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var versionService *version.Service
 	{
 		versionConfig := version.Config{
@@ -349,13 +487,16 @@ func New(config Config) (*Service, error) {
 	}
 
 	s := &Service{
-		Version: versionService,
+		DeletionPlan: deletionPlan,
+		Version:      versionService,
 
 		bootOnce:                    sync.Once{},
 		clusterController:           clusterController,
 		controlPlaneController:      controlPlaneController,
 		machineDeploymentController: machineDeploymentController,
 		operatorCollector:           operatorCollector,
+		orphanSweeper:               orphanSweeper,
+		webhook:                     webhookServer,
 	}
 
 	return s, nil
@@ -371,6 +512,22 @@ func (s *Service) Boot(ctx context.Context) {
 			}
 		}()
 
+		go func() {
+			err := s.orphanSweeper.Boot(ctx)
+			if err != nil {
+				panic(microerror.JSON(err))
+			}
+		}()
+
+		if s.webhook != nil {
+			go func() {
+				err := s.webhook.Boot(ctx)
+				if err != nil {
+					panic(microerror.JSON(err))
+				}
+			}()
+		}
+
 		// Start the controllers.
 		go s.clusterController.Boot(ctx)
 		go s.controlPlaneController.Boot(ctx)
@@ -384,28 +541,3 @@ func newCommonClusterObjectFunc(provider string) func() infrastructurev1alpha3.C
 		return new(infrastructurev1alpha3.AWSCluster)
 	}
 }
-
-func parseClusterIPRange(ipRange string) (net.IP, net.IP, error) {
-	_, cidr, err := net.ParseCIDR(ipRange)
-	if cidr == nil {
-		return nil, nil, microerror.Maskf(invalidConfigError, "invalid Kubernetes ClusterIPRange '%s': cidr == nil", ipRange)
-	} else if err != nil {
-		return nil, nil, microerror.Maskf(invalidConfigError, "invalid Kubernetes ClusterIPRange '%s': %q", ipRange, err)
-	}
-
-	ones, bits := cidr.Mask.Size()
-	if bits != 32 {
-		return nil, nil, microerror.Maskf(invalidConfigError, "Kubernetes ClusterIPRange CIDR must be an IPv4 range")
-	}
-
-	// Node gets /24 from Kubernetes and each POD receives one IP from this
-	// block. Therefore CIDR block must be at least /24.
-	if ones > 24 {
-		return nil, nil, microerror.Maskf(invalidConfigError, "Kubernetes ClusterIPRange CIDR network block must be at least /24")
-	}
-
-	networkIP := cidr.IP.To4()
-	apiServerIP := net.IPv4(networkIP[0], networkIP[1], networkIP[2], apiServerIPLastOctet)
-
-	return networkIP, apiServerIP, nil
-}
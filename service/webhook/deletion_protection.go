@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/cluster-operator/v3/pkg/annotation"
+	"github.com/giantswarm/cluster-operator/v3/service/controller/key"
+)
+
+func (w *Webhook) serveDeletionProtection(rw http.ResponseWriter, r *http.Request) {
+	ctx := context.Background()
+
+	var review admissionv1.AdmissionReview
+	err := json.NewDecoder(r.Body).Decode(&review)
+	if err != nil {
+		w.logger.Errorf(ctx, err, "failed to decode admission review")
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(rw, "admission review must contain a request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = reviewDeletion(review.Request)
+	review.Request = nil
+
+	if !review.Response.Allowed {
+		w.logger.Debugf(ctx, "rejected deletion of protected cluster %#q", review.Response.Result.Details.Name)
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(rw).Encode(review)
+	if err != nil {
+		w.logger.Errorf(ctx, err, "failed to encode admission review")
+	}
+}
+
+// reviewDeletion rejects deletions of objects carrying the deletion
+// protection annotation. All other requests are allowed, so that a
+// misconfigured webhook does not block unrelated operations.
+func reviewDeletion(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	allowed := &admissionv1.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+
+	if req.Operation != admissionv1.Delete || len(req.OldObject.Raw) == 0 {
+		return allowed
+	}
+
+	var obj metav1.PartialObjectMetadata
+	err := json.Unmarshal(req.OldObject.Raw, &obj)
+	if err != nil || !key.IsDeletionProtected(&obj) {
+		return allowed
+	}
+
+	msg := fmt.Sprintf("cluster %#q is protected from deletion, remove annotation %#q first", req.Name, annotation.DeletionProtection)
+	if reason := obj.GetAnnotations()[annotation.DeletionProtection]; reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, reason)
+	}
+
+	return &admissionv1.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: msg,
+			Reason:  metav1.StatusReasonForbidden,
+			Code:    http.StatusForbidden,
+			Details: &metav1.StatusDetails{
+				Name:  req.Name,
+				Group: req.Kind.Group,
+				Kind:  req.Kind.Kind,
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"strconv"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func Test_reviewDeletion(t *testing.T) {
+	testCases := []struct {
+		name            string
+		request         *admissionv1.AdmissionRequest
+		expectedAllowed bool
+		expectedMessage string
+	}{
+		{
+			name: "case 0: deletion of unprotected cluster is allowed",
+			request: &admissionv1.AdmissionRequest{
+				UID:       "4a1e0a0b",
+				Name:      "8y5ck",
+				Operation: admissionv1.Delete,
+				OldObject: runtime.RawExtension{
+					Raw: []byte(`{"metadata":{"name":"8y5ck","annotations":{"foo":"bar"}}}`),
+				},
+			},
+			expectedAllowed: true,
+		},
+		{
+			name: "case 1: deletion of protected cluster is rejected",
+			request: &admissionv1.AdmissionRequest{
+				UID:       "4a1e0a0b",
+				Name:      "8y5ck",
+				Operation: admissionv1.Delete,
+				OldObject: runtime.RawExtension{
+					Raw: []byte(`{"metadata":{"name":"8y5ck","annotations":{"cluster-operator.giantswarm.io/deletion-protection":""}}}`),
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "cluster `8y5ck` is protected from deletion, remove annotation `cluster-operator.giantswarm.io/deletion-protection` first",
+		},
+		{
+			name: "case 2: rejection names the reason of the protection",
+			request: &admissionv1.AdmissionRequest{
+				UID:       "4a1e0a0b",
+				Name:      "8y5ck",
+				Operation: admissionv1.Delete,
+				OldObject: runtime.RawExtension{
+					Raw: []byte(`{"metadata":{"name":"8y5ck","annotations":{"cluster-operator.giantswarm.io/deletion-protection":"production workloads"}}}`),
+				},
+			},
+			expectedAllowed: false,
+			expectedMessage: "cluster `8y5ck` is protected from deletion, remove annotation `cluster-operator.giantswarm.io/deletion-protection` first: production workloads",
+		},
+		{
+			name: "case 3: updates of protected cluster are allowed",
+			request: &admissionv1.AdmissionRequest{
+				UID:       "4a1e0a0b",
+				Name:      "8y5ck",
+				Operation: admissionv1.Update,
+				OldObject: runtime.RawExtension{
+					Raw: []byte(`{"metadata":{"name":"8y5ck","annotations":{"cluster-operator.giantswarm.io/deletion-protection":""}}}`),
+				},
+			},
+			expectedAllowed: true,
+		},
+		{
+			name: "case 4: deletion without old object is allowed",
+			request: &admissionv1.AdmissionRequest{
+				UID:       "4a1e0a0b",
+				Name:      "8y5ck",
+				Operation: admissionv1.Delete,
+			},
+			expectedAllowed: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			res := reviewDeletion(tc.request)
+
+			if res.UID != tc.request.UID {
+				t.Fatalf("expected %#q to be equal to %#q", tc.request.UID, res.UID)
+			}
+			if res.Allowed != tc.expectedAllowed {
+				t.Fatalf("expected %t to be equal to %t", tc.expectedAllowed, res.Allowed)
+			}
+
+			var message string
+			if res.Result != nil {
+				message = res.Result.Message
+			}
+			if message != tc.expectedMessage {
+				t.Fatalf("expected %#q to be equal to %#q", tc.expectedMessage, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+)
+
+const (
+	// DeletionProtectionPath is the path of the validating admission webhook
+	// rejecting deletions of protected Cluster CRs.
+	DeletionProtectionPath = "/validate/deletion-protection"
+)
+
+type Config struct {
+	Logger micrologger.Logger
+
+	// Address is the address the webhook server listens on, e.g. :8443.
+	Address string
+	// CrtFile is the path of the TLS certificate served to the Kubernetes API.
+	CrtFile string
+	// KeyFile is the path of the TLS private key of the certificate.
+	KeyFile string
+}
+
+// Webhook serves the validating admission webhooks of the operator over TLS,
+// separately from the microkit server serving metrics and health checks.
+type Webhook struct {
+	logger micrologger.Logger
+
+	crtFile string
+	keyFile string
+	server  *http.Server
+}
+
+func New(config Config) (*Webhook, error) {
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+
+	if config.Address == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Address must not be empty", config)
+	}
+	if config.CrtFile == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CrtFile must not be empty", config)
+	}
+	if config.KeyFile == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.KeyFile must not be empty", config)
+	}
+
+	w := &Webhook{
+		logger: config.Logger,
+
+		crtFile: config.CrtFile,
+		keyFile: config.KeyFile,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(DeletionProtectionPath, w.serveDeletionProtection)
+
+	w.server = &http.Server{
+		Addr:         config.Address,
+		Handler:      mux,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	return w, nil
+}
+
+func (w *Webhook) Boot(ctx context.Context) error {
+	w.logger.Debugf(ctx, "starting webhook server on %#q", w.server.Addr)
+
+	err := w.server.ListenAndServeTLS(w.crtFile, w.keyFile)
+	if err == http.ErrServerClosed {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}